@@ -0,0 +1,348 @@
+package gonumfmt
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// decimal хранит число в виде десятичных цифр без потери точности.
+// Значение равно 0.digits × 10^exp, например 123.45 = {digits: "12345", exp: 3}.
+type decimal struct {
+	negative bool
+	digits   []byte // значащие цифры без ведущих и хвостовых нулей, пусто для нуля
+	exp      int    // позиция десятичной точки относительно начала digits
+}
+
+// isZero проверяет, равно ли число нулю
+func (d decimal) isZero() bool {
+	return len(d.digits) == 0
+}
+
+// abs возвращает модуль числа
+func (d decimal) abs() decimal {
+	d.negative = false
+	return d
+}
+
+// shift умножает число на 10^n
+func (d decimal) shift(n int) decimal {
+	if !d.isZero() {
+		d.exp += n
+	}
+	return d
+}
+
+// parts возвращает целую и дробную части в виде строк ASCII-цифр
+func (d decimal) parts() (intPart, fracPart string) {
+	digits := string(d.digits)
+	switch {
+	case d.isZero():
+		return "0", ""
+	case d.exp <= 0:
+		return "0", strings.Repeat("0", -d.exp) + digits
+	case d.exp >= len(digits):
+		return digits + strings.Repeat("0", d.exp-len(digits)), ""
+	default:
+		return digits[:d.exp], digits[d.exp:]
+	}
+}
+
+// round округляет число до fracDigits знаков после запятой по правилам mode
+func (d decimal) round(fracDigits int, mode RoundingMode) decimal {
+	keep := d.exp + fracDigits
+	if keep >= len(d.digits) {
+		return d
+	}
+
+	// Отбрасываемые цифры; при keep < 0 перед ними стоят неявные нули
+	var kept, dropped []byte
+	firstDropped := byte('0')
+	if keep >= 0 {
+		kept, dropped = d.digits[:keep], d.digits[keep:]
+		firstDropped = dropped[0]
+	}
+
+	var roundAway bool
+	switch mode {
+	case RoundDown:
+		roundAway = false
+	case RoundUp:
+		roundAway = true
+	case RoundCeiling:
+		roundAway = !d.negative
+	case RoundFloor:
+		roundAway = d.negative
+	default:
+		switch {
+		case firstDropped > '5':
+			roundAway = true
+		case firstDropped < '5':
+			roundAway = false
+		case len(dropped) > 1:
+			// После пятерки есть ненулевые цифры (хвостовые нули уже удалены)
+			roundAway = true
+		case mode == RoundHalfUp:
+			roundAway = true
+		case mode == RoundHalfDown:
+			roundAway = false
+		default:
+			// Банковское округление: к ближайшему четному
+			roundAway = len(kept) > 0 && (kept[len(kept)-1]-'0')%2 == 1
+		}
+	}
+
+	result := decimal{negative: d.negative, exp: d.exp}
+	if !roundAway {
+		result.digits = trimTrailingZeroDigits(kept)
+		if len(result.digits) == 0 {
+			result.exp = 0
+		}
+		return result
+	}
+
+	if keep <= 0 {
+		// Все цифры отброшены, результат равен одной единице последнего разряда
+		return decimal{negative: d.negative, digits: []byte{'1'}, exp: 1 - fracDigits}
+	}
+
+	digits := append([]byte(nil), kept...)
+	i := len(digits) - 1
+	for i >= 0 && digits[i] == '9' {
+		digits[i] = '0'
+		i--
+	}
+	if i < 0 {
+		digits = append([]byte{'1'}, digits...)
+		result.exp++
+	} else {
+		digits[i]++
+	}
+
+	result.digits = trimTrailingZeroDigits(digits)
+	return result
+}
+
+// roundIncrement округляет число до кратного increment по правилам mode:
+// 1.23 с шагом 0.05 дает 1.25, 1.22 - 1.2. Шаг должен быть положительным.
+func (d decimal) roundIncrement(increment decimal, mode RoundingMode) decimal {
+	if d.isZero() {
+		return d
+	}
+
+	// Переходим к целым: число и шаг в единицах самого младшего разряда
+	scale := max(d.fracDigits(), increment.fracDigits())
+	value, step := d.scaled(scale), increment.scaled(scale)
+	quotient, remainder := new(big.Int).QuoRem(value, step, new(big.Int))
+
+	if remainder.Sign() != 0 {
+		var roundAway bool
+		switch mode {
+		case RoundDown:
+			roundAway = false
+		case RoundUp:
+			roundAway = true
+		case RoundCeiling:
+			roundAway = !d.negative
+		case RoundFloor:
+			roundAway = d.negative
+		default:
+			switch remainder.Lsh(remainder, 1).Cmp(step) {
+			case 1:
+				roundAway = true
+			case -1:
+				roundAway = false
+			default:
+				switch mode {
+				case RoundHalfUp:
+					roundAway = true
+				case RoundHalfDown:
+					roundAway = false
+				default:
+					// Банковское округление: к четному числу шагов
+					roundAway = quotient.Bit(0) == 1
+				}
+			}
+		}
+		if roundAway {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+
+	result := decimalFromBigInt(quotient.Mul(quotient, step)).shift(-scale)
+	result.negative = d.negative
+	return result
+}
+
+// fracDigits возвращает количество знаков после запятой
+func (d decimal) fracDigits() int {
+	return max(len(d.digits)-d.exp, 0)
+}
+
+// scaled возвращает модуль числа, умноженный на 10^scale, как целое; scale
+// не меньше fracDigits
+func (d decimal) scaled(scale int) *big.Int {
+	value, _ := new(big.Int).SetString(string(d.digits), 10)
+	if value == nil {
+		return new(big.Int)
+	}
+	power := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale+d.exp-len(d.digits))), nil)
+	return value.Mul(value, power)
+}
+
+// trimTrailingZeroDigits удаляет хвостовые нули из цифр
+func trimTrailingZeroDigits(digits []byte) []byte {
+	for len(digits) > 0 && digits[len(digits)-1] == '0' {
+		digits = digits[:len(digits)-1]
+	}
+	return digits
+}
+
+// parseDecimal разбирает строку вида "-123.456e-7" в decimal.
+// Второе значение равно false, если строка не является десятичным числом.
+func parseDecimal(s string) (decimal, bool) {
+	var d decimal
+
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		d.negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	mantissa, exponent, hasExponent := strings.Cut(strings.ToLower(s), "e")
+	exp := 0
+	if hasExponent {
+		var err error
+		if exp, err = strconv.Atoi(exponent); err != nil {
+			return decimal{}, false
+		}
+	}
+
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	if intPart == "" && fracPart == "" {
+		return decimal{}, false
+	}
+	for _, part := range []string{intPart, fracPart} {
+		for i := 0; i < len(part); i++ {
+			if part[i] < '0' || part[i] > '9' {
+				return decimal{}, false
+			}
+		}
+	}
+
+	digits := []byte(intPart + fracPart)
+	exp += len(intPart)
+
+	// Нормализуем: убираем ведущие и хвостовые нули
+	for len(digits) > 0 && digits[0] == '0' {
+		digits = digits[1:]
+		exp--
+	}
+	d.digits = trimTrailingZeroDigits(digits)
+	if len(d.digits) == 0 {
+		return decimal{}, true
+	}
+
+	d.exp = exp
+	return d, true
+}
+
+// parseDecimalString проверяет простую десятичную строку вида "-12345.678900"
+// и переводит ее в decimal; ошибки указывают позицию в строке
+func parseDecimalString(s string) (decimal, error) {
+	if s == "" {
+		return decimal{}, &ParseError{Input: s, Err: ErrEmptyInput}
+	}
+
+	start := 0
+	if s[0] == '-' || s[0] == '+' {
+		start = 1
+	}
+
+	digits := 0
+	seenPoint := false
+	for i := start; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+			digits++
+		case c == '.' && !seenPoint:
+			seenPoint = true
+		case c == '.':
+			return decimal{}, &ParseError{Input: s, Offset: i, Err: ErrDuplicateDecimalSeparator}
+		default:
+			return decimal{}, &ParseError{Input: s, Offset: i, Err: ErrUnexpectedCharacter}
+		}
+	}
+
+	if digits == 0 {
+		return decimal{}, &ParseError{Input: s, Offset: start, Err: ErrNoDigits}
+	}
+
+	d, _ := parseDecimal(s)
+	return d, nil
+}
+
+// decimalFromFloat переводит float64 в decimal по кратчайшей десятичной записи,
+// которая однозначно задает число (как strconv.FormatFloat с точностью -1).
+// Поэтому 1.005 округляется как десятичное 1.005, а не как его двоичное приближение.
+func decimalFromFloat(number float64) decimal {
+	d, _ := parseDecimal(strconv.FormatFloat(number, 'e', -1, 64))
+	return d
+}
+
+// decimalFromInt переводит int64 в decimal без потери цифр
+func decimalFromInt(number int64) decimal {
+	d, _ := parseDecimal(strconv.FormatInt(number, 10))
+	return d
+}
+
+// decimalFromBigInt переводит *big.Int в decimal
+func decimalFromBigInt(x *big.Int) decimal {
+	d, _ := parseDecimal(x.String())
+	return d
+}
+
+// decimalFromBigFloat переводит конечный *big.Float в decimal, используя
+// кратчайшую десятичную запись, однозначно задающую x при его точности
+func decimalFromBigFloat(x *big.Float) decimal {
+	d, _ := parseDecimal(x.Text('e', -1))
+	return d
+}
+
+// decimalFromBigRat переводит *big.Rat в decimal с fracDigits знаками после запятой.
+// Если дробь бесконечная, к результату добавляется ненулевая цифра после
+// последнего знака, чтобы округление до fracDigits-1 знаков учитывало остаток.
+func decimalFromBigRat(x *big.Rat, fracDigits int) decimal {
+	if x.IsInt() {
+		return decimalFromBigInt(x.Num())
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(fracDigits)), nil)
+	num := new(big.Int).Abs(x.Num())
+	num.Mul(num, scale)
+
+	quotient, remainder := new(big.Int).QuoRem(num, x.Denom(), new(big.Int))
+
+	text := quotient.String()
+	if remainder.Sign() != 0 {
+		text += "1"
+		fracDigits++
+	}
+
+	d, _ := parseDecimal(text)
+	d = d.shift(-fracDigits)
+	d.negative = x.Sign() < 0
+	return d
+}
+
+// ratFractionDigits оценивает, сколько знаков дроби нужно, чтобы после сдвигов
+// процентной и научной записи округление по options было точным
+func ratFractionDigits(x *big.Rat, options Options) int {
+	digits := options.MaximumFractionDigits + 3
+
+	// Для чисел меньше единицы научная запись округляет значащие цифры
+	magnitude := len(new(big.Int).Abs(x.Num()).String()) - len(x.Denom().String())
+	if magnitude < 0 {
+		digits -= magnitude
+	}
+	return digits
+}
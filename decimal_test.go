@@ -0,0 +1,167 @@
+//go:build !gonumfmt_minimal
+
+package gonumfmt
+
+import "testing"
+
+func TestFormatter_RoundingConformance(t *testing.T) {
+	modes := []RoundingMode{RoundHalfEven, RoundHalfUp, RoundHalfDown, RoundCeiling, RoundFloor, RoundDown, RoundUp}
+
+	tests := []struct {
+		name      string
+		number    float64
+		precision int
+		expected  [7]string // в порядке modes
+	}{
+		{"1.005", 1.005, 2, [7]string{"1", "1.01", "1", "1.01", "1", "1", "1.01"}},
+		{"1.015", 1.015, 2, [7]string{"1.02", "1.02", "1.01", "1.02", "1.01", "1.01", "1.02"}},
+		{"1.025", 1.025, 2, [7]string{"1.02", "1.03", "1.02", "1.03", "1.02", "1.02", "1.03"}},
+		{"-1.005", -1.005, 2, [7]string{"-1", "-1.01", "-1", "-1", "-1.01", "-1", "-1.01"}},
+		{"2.675", 2.675, 2, [7]string{"2.68", "2.68", "2.67", "2.68", "2.67", "2.67", "2.68"}},
+		{"0.125", 0.125, 2, [7]string{"0.12", "0.13", "0.12", "0.13", "0.12", "0.12", "0.13"}},
+		{"-0.125", -0.125, 2, [7]string{"-0.12", "-0.13", "-0.12", "-0.12", "-0.13", "-0.12", "-0.13"}},
+		{"Above tie", 1.0051, 2, [7]string{"1.01", "1.01", "1.01", "1.01", "1", "1", "1.01"}},
+		{"Carry", 9.995, 2, [7]string{"10", "10", "9.99", "10", "9.99", "9.99", "10"}},
+		{"2.5 to integer", 2.5, 0, [7]string{"2", "3", "2", "3", "2", "2", "3"}},
+		{"-2.5 to integer", -2.5, 0, [7]string{"-2", "-3", "-2", "-2", "-3", "-2", "-3"}},
+		{"0.5 to integer", 0.5, 0, [7]string{"0", "1", "0", "1", "0", "0", "1"}},
+	}
+
+	for _, tt := range tests {
+		for i, mode := range modes {
+			formatter := NewFormatter(
+				WithLocale("en"),
+				WithPrecision(0, tt.precision),
+				WithRoundingMode(mode),
+			)
+
+			result := formatter.Format(tt.number)
+			if result != tt.expected[i] {
+				t.Errorf("Rounding %s with mode %d = %s, expected %s", tt.name, mode, result, tt.expected[i])
+			}
+		}
+	}
+}
+
+func TestFormatter_RoundingSmallMagnitudes(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   float64
+		mode     RoundingMode
+		expected string
+	}{
+		{"Below precision half-even", 0.004, RoundHalfEven, "0"},
+		{"Below precision up", 0.004, RoundUp, "0.01"},
+		{"Below precision ceiling negative", -0.004, RoundCeiling, "-0"},
+		{"Below precision floor negative", -0.004, RoundFloor, "-0.01"},
+		{"Tiny exponent", 1e-300, RoundUp, "0.01"},
+	}
+
+	for _, tt := range tests {
+		formatter := NewFormatter(
+			WithLocale("en"),
+			WithPrecision(0, 2),
+			WithRoundingMode(tt.mode),
+		)
+
+		result := formatter.Format(tt.number)
+		if result != tt.expected {
+			t.Errorf("Rounding %s = %s, expected %s", tt.name, result, tt.expected)
+		}
+	}
+}
+
+func TestFormatter_RoundingIncrement(t *testing.T) {
+	modes := []RoundingMode{RoundHalfEven, RoundHalfUp, RoundHalfDown, RoundCeiling, RoundFloor, RoundDown, RoundUp}
+
+	tests := []struct {
+		name      string
+		number    float64
+		increment float64
+		expected  [7]string // в порядке modes
+	}{
+		{"1.225 to 0.05", 1.225, 0.05, [7]string{"1.2", "1.25", "1.2", "1.25", "1.2", "1.2", "1.25"}},
+		{"-1.225 to 0.05", -1.225, 0.05, [7]string{"-1.2", "-1.25", "-1.2", "-1.2", "-1.25", "-1.2", "-1.25"}},
+		{"1.26 to 0.05", 1.26, 0.05, [7]string{"1.25", "1.25", "1.25", "1.3", "1.25", "1.25", "1.3"}},
+		{"Exact multiple", 1.05, 0.05, [7]string{"1.05", "1.05", "1.05", "1.05", "1.05", "1.05", "1.05"}},
+		{"1.375 to 0.25", 1.375, 0.25, [7]string{"1.5", "1.5", "1.25", "1.5", "1.25", "1.25", "1.5"}},
+		{"1.1 to 0.25", 1.1, 0.25, [7]string{"1", "1", "1", "1.25", "1", "1", "1.25"}},
+		{"12.5 to 5", 12.5, 5, [7]string{"10", "15", "10", "15", "10", "10", "15"}},
+		{"-7.5 to 5", -7.5, 5, [7]string{"-10", "-10", "-5", "-5", "-10", "-5", "-10"}},
+		{"2 to 5", 2, 5, [7]string{"0", "0", "0", "5", "0", "0", "5"}},
+	}
+
+	for _, tt := range tests {
+		for i, mode := range modes {
+			formatter := NewFormatter(
+				WithLocale("en"),
+				WithPrecision(0, 2),
+				WithRoundingIncrement(tt.increment),
+				WithRoundingMode(mode),
+			)
+
+			result := formatter.Format(tt.number)
+			if result != tt.expected[i] {
+				t.Errorf("Rounding %s with mode %d = %s, expected %s", tt.name, mode, result, tt.expected[i])
+			}
+		}
+	}
+}
+
+func TestFormatter_RoundingIncrementScope(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   float64
+		opts     []FormatterOption
+		expected string
+	}{
+		{"Decimal", 1.23, []FormatterOption{WithStyle(Decimal)}, "1.25"},
+		{"Scientific notation rounds by digits", 1.23e6, []FormatterOption{WithNotation(ScientificNotation)}, "1.23E6"},
+		{"Scientific style rounds by digits", 1.23e6, []FormatterOption{WithStyle(Scientific)}, "1.23E6"},
+		{"Compact rounds by digits", 1.23e6, []FormatterOption{WithStyle(Compact)}, "1.23M"},
+		{"Compact below first range", 123.23, []FormatterOption{WithStyle(Compact)}, "123.23"},
+		{"Percent rounds by digits", 0.1223, []FormatterOption{WithStyle(Percent)}, "12.23%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]FormatterOption{WithLocale("en"), WithPrecision(0, 2), WithRoundingIncrement(0.05)}, tt.opts...)
+			if result := NewFormatter(opts...).Format(tt.number); result != tt.expected {
+				t.Errorf("Format(%v) = %q, expected %q", tt.number, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatter_CurrencyUsage(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   float64
+		opts     []FormatterOption
+		expected string
+	}{
+		{"CHF standard", 1.23, []FormatterOption{WithLocale("de-CH"), WithCurrency("CHF")}, "CHF 1.23"},
+		{"CHF cash", 1.23, []FormatterOption{WithLocale("de-CH"), WithCurrency("CHF"), WithCurrencyUsage(CurrencyUsageCash)}, "CHF 1.25"},
+		{"CHF cash tie", 1.225, []FormatterOption{WithLocale("de-CH"), WithCurrency("CHF"), WithCurrencyUsage(CurrencyUsageCash)}, "CHF 1.20"},
+		{"CHF cash half up", 1.225, []FormatterOption{WithLocale("de-CH"), WithCurrency("CHF"), WithCurrencyUsage(CurrencyUsageCash), WithRoundingMode(RoundHalfUp)}, "CHF 1.25"},
+		{"CAD cash", 19.99, []FormatterOption{WithLocale("en-CA"), WithCurrency("CAD"), WithCurrencyUsage(CurrencyUsageCash)}, "$20.00"},
+		{"DKK cash", 1.23, []FormatterOption{WithLocale("en"), WithCurrency("DKK"), WithCurrencyUsage(CurrencyUsageCash)}, "DKK1.00"},
+		{"Cash digits", 12.5, []FormatterOption{WithLocale("en"), WithCurrency("AMD"), WithCurrencyUsage(CurrencyUsageCash)}, "AMD12"},
+		{"USD cash unchanged", 1.23, []FormatterOption{WithLocale("en"), WithCurrency("USD"), WithCurrencyUsage(CurrencyUsageCash)}, "$1.23"},
+		{"Explicit increment", 1.23, []FormatterOption{WithLocale("de-CH"), WithCurrency("CHF"), WithCurrencyUsage(CurrencyUsageCash), WithRoundingIncrement(0.5)}, "CHF 1.00"},
+		{"Explicit precision", 1.23, []FormatterOption{WithLocale("de-CH"), WithCurrency("CHF"), WithCurrencyUsage(CurrencyUsageCash), WithPrecision(0, 2)}, "CHF 1.25"},
+		{"Price to 5", 1234.56, []FormatterOption{WithLocale("en"), WithCurrency("USD"), WithRoundingIncrement(5)}, "$1,235.00"},
+		{"Increment ignored in scientific notation", 1234.56, []FormatterOption{WithLocale("en"), WithCurrency("USD"),
+			WithRoundingIncrement(0.05), WithNotation(ScientificNotation)}, "$1.23E3"},
+		{"Increment ignored in cash scientific notation", 1.23, []FormatterOption{WithLocale("de-CH"), WithCurrency("CHF"),
+			WithCurrencyUsage(CurrencyUsageCash), WithNotation(ScientificNotation)}, "CHF 1.23E0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := NewFormatter(tt.opts...).Format(tt.number); result != tt.expected {
+				t.Errorf("Format(%v) = %q, expected %q", tt.number, result, tt.expected)
+			}
+		})
+	}
+}
@@ -1,3 +1,5 @@
+//go:build !gonumfmt_minimal
+
 package gonumfmt_test
 
 import (
@@ -162,7 +164,7 @@ func Example_customConfiguration() {
 		gonumfmt.WithLocale("ru-RU"),
 		gonumfmt.WithCurrency("USD"),
 		gonumfmt.WithPrecision(2, 4),
-		gonumfmt.WithGrouping(true),
+		gonumfmt.WithGroupingStrategy(gonumfmt.GroupingAuto),
 	)
 
 	result := customFormatter.Format(1234.5678)
@@ -2,14 +2,17 @@ package gonumfmt
 
 import (
 	"math"
+	"math/big"
 	"strconv"
 	"strings"
 )
 
 // Formatter основной тип для форматирования чисел
 type Formatter struct {
-	options Options
-	locale  *LocaleData
+	options   Options
+	locale    *LocaleData
+	numbering *numberingSystem
+	increment decimal // шаг округления, ноль - без шага
 }
 
 // NewFormatter создает новый форматтер с указанными опциями
@@ -20,100 +23,198 @@ func NewFormatter(opts ...FormatterOption) *Formatter {
 	}
 
 	// Загружаем данные локали
-	locale := GetLocaleData(options.Locale)
-	if locale == nil {
-		// Fallback на английскую локаль
-		locale = GetLocaleData("en")
-	}
+	locale := lookupLocaleData(options.Locale)
+	options.applyCurrencyDigits()
+
+	// Числовая система может заменить цифры и разделители локали
+	numbering := resolveNumberingSystem(options, locale)
 
 	return &Formatter{
-		options: options,
-		locale:  locale,
+		options:   options,
+		locale:    numbering.withSymbols(locale),
+		numbering: numbering,
+		increment: roundingIncrement(options.RoundingIncrement),
+	}
+}
+
+// roundingIncrement переводит шаг округления в decimal; некорректный шаг
+// отключает округление по шагу
+func roundingIncrement(increment float64) decimal {
+	if increment <= 0 || math.IsInf(increment, 0) || math.IsNaN(increment) {
+		return decimal{}
 	}
+	return decimalFromFloat(increment)
 }
 
 // Format форматирует число в строку
 func (f *Formatter) Format(number float64) string {
 	// Проверка специальных значений
 	if math.IsNaN(number) {
-		return "NaN"
+		return f.nan()
 	}
 	if math.IsInf(number, 1) {
-		return "∞"
+		return f.infinity()
 	}
 	if math.IsInf(number, -1) {
-		return "-∞"
+		return "-" + f.infinity()
+	}
+
+	return f.formatValue(decimalFromFloat(number))
+}
+
+// infinity возвращает символ бесконечности локали
+func (f *Formatter) infinity() string {
+	if f.locale.Infinity != "" {
+		return f.locale.Infinity
+	}
+	return "∞"
+}
+
+// nan возвращает обозначение NaN локали
+func (f *Formatter) nan() string {
+	if f.locale.NaN != "" {
+		return f.locale.NaN
+	}
+	return "NaN"
+}
+
+// FormatInt форматирует целое число
+func (f *Formatter) FormatInt(number int64) string {
+	return f.formatValue(decimalFromInt(number))
+}
+
+// FormatBigInt форматирует целое число произвольной длины без потери цифр
+func (f *Formatter) FormatBigInt(number *big.Int) string {
+	if number == nil {
+		return f.nan()
+	}
+	return f.formatValue(decimalFromBigInt(number))
+}
+
+// FormatBigFloat форматирует число произвольной точности без перевода в float64
+func (f *Formatter) FormatBigFloat(number *big.Float) string {
+	if number == nil {
+		return f.nan()
+	}
+	if number.IsInf() {
+		return f.Format(math.Inf(number.Sign()))
+	}
+	return f.formatValue(decimalFromBigFloat(number))
+}
+
+// FormatBigRat форматирует рациональное число, округляя его точно по RoundingMode
+func (f *Formatter) FormatBigRat(number *big.Rat) string {
+	if number == nil {
+		return f.nan()
+	}
+	return f.formatValue(decimalFromBigRat(number, ratFractionDigits(number, f.options)))
+}
+
+// FormatDecimalString форматирует число, заданное десятичной строкой
+// (например, значение NUMERIC из базы данных), округляя сами десятичные цифры.
+// Помимо чисел вида "-12345.678900" принимаются "NaN", "Infinity" и "-Infinity".
+func (f *Formatter) FormatDecimalString(number string) (string, error) {
+	switch number {
+	case "NaN":
+		return f.Format(math.NaN()), nil
+	case "Infinity", "+Infinity":
+		return f.Format(math.Inf(1)), nil
+	case "-Infinity":
+		return f.Format(math.Inf(-1)), nil
 	}
 
+	d, err := parseDecimalString(number)
+	if err != nil {
+		return "", err
+	}
+	return f.formatValue(d), nil
+}
+
+// formatValue форматирует точное десятичное представление числа в текущем стиле
+func (f *Formatter) formatValue(number decimal) string {
 	switch f.options.Style {
 	case Decimal:
-		return f.formatDecimal(number)
+		return f.formatNumber(f.roundIncrement(number))
 	case Currency:
-		return f.formatCurrency(number)
+		return f.formatCurrency(f.roundIncrement(number))
 	case Percent:
 		return f.formatPercent(number)
 	case Scientific:
-		return f.formatScientific(number)
+		return f.formatScientific(number, f.exponentStep())
 	case Compact:
 		return f.formatCompact(number)
 	default:
+		return f.formatNumber(number)
+	}
+}
+
+// formatNumber форматирует число в выбранной нотации; валюта и знак процента
+// добавляются вокруг результата, поэтому нотация работает с любым стилем
+func (f *Formatter) formatNumber(number decimal) string {
+	if f.options.Notation == Standard {
 		return f.formatDecimal(number)
 	}
+	return f.formatScientific(number, f.exponentStep())
 }
 
-// FormatInt форматирует целое число
-func (f *Formatter) FormatInt(number int64) string {
-	return f.Format(float64(number))
+// formatUnsigned форматирует модуль числа в выбранной нотации;
+// знак расставляет шаблон валюты
+func (f *Formatter) formatUnsigned(number decimal) string {
+	if f.options.Notation == Standard {
+		return f.formatDigits(number)
+	}
+	return f.scientificDigits(number, f.exponentStep())
 }
 
-// formatDecimal форматирует число в десятичном формате
-func (f *Formatter) formatDecimal(number float64) string {
-	// Обработка очень маленьких чисел
-	if isVerySmallNumber(number) {
-		return f.formatVerySmallNumber(number)
+// exponentStep возвращает шаг порядка: 3 для инженерной нотации, иначе 1
+func (f *Formatter) exponentStep() int {
+	if f.options.Notation == Engineering {
+		return 3
 	}
+	return 1
+}
 
+// formatDecimal форматирует число в десятичном формате
+func (f *Formatter) formatDecimal(number decimal) string {
 	// Определяем знак
 	sign := f.getSign(number)
-	absNumber := math.Abs(number)
 
+	// Применяем шаблон знака
+	return f.applySignPattern(f.formatDigits(number), sign)
+}
+
+// formatDigits форматирует модуль числа: округление, группировка и цифры
+// числовой системы, но без знака
+func (f *Formatter) formatDigits(number decimal) string {
 	// Округляем число
-	rounded := f.roundNumber(absNumber)
+	rounded := f.roundNumber(number)
 
 	// Разделяем на целую и дробную части
-	intPart, fracPart := f.splitNumber(rounded)
+	intPart, fracPart := rounded.parts()
 
 	// Форматируем целую часть с группировкой
-	formattedInt := f.formatIntegerPart(intPart)
+	formattedInt := f.numbering.transliterate(f.formatIntegerPart(intPart))
 
 	// Форматируем дробную часть
-	formattedFrac := f.formatFractionalPart(fracPart)
+	formattedFrac := f.numbering.transliterate(f.formatFractionalPart(fracPart))
 
 	// Собираем результат
-	var result string
 	if formattedFrac != "" {
-		result = formattedInt + f.locale.DecimalSeparator + formattedFrac
-	} else {
-		result = formattedInt
+		return formattedInt + f.locale.DecimalSeparator + formattedFrac
 	}
-
-	// Применяем шаблон знака
-	return f.applySignPattern(result, sign)
+	return formattedInt
 }
 
-// formatCurrency форматирует число как валюту
-func (f *Formatter) formatCurrency(number float64) string {
-	decimalStr := f.formatDecimal(number)
-
+// formatCurrency форматирует число как валюту. Знак ставится по шаблону
+// валюты локали: перед обозначением ("-$5.00"), после него ("CHF-5.00")
+// или скобками в бухгалтерском формате ("($5.00)").
+func (f *Formatter) formatCurrency(number decimal) string {
 	if f.options.Currency == "" {
-		return decimalStr
+		return f.formatNumber(number)
 	}
 
-	currencyData, exists := f.locale.CurrencyFormats[f.options.Currency]
-	if !exists {
-		// Fallback для неизвестной валюты - код валюты перед числом
-		return f.options.Currency + decimalStr
-	}
+	// Валюты без данных локали берутся из общих данных, неизвестные - кодом перед числом
+	currencyData := f.currencyFormat(f.options.Currency)
 
 	var currencyDisplay string
 	switch f.options.CurrencyDisplay {
@@ -127,20 +228,63 @@ func (f *Formatter) formatCurrency(number float64) string {
 		currencyDisplay = currencyData.Symbol
 	}
 
+	// Выбираем шаблон по знаку; плюс ставится туда же, где минус,
+	// а если отрицательный шаблон без знака (скобки) - перед суммой
+	sign := f.getSign(number)
+	if f.options.SignDisplay == SignNever {
+		sign = ""
+	}
+	positive, negative := f.currencyPatterns(currencyData, f.options.CurrencySign)
+	format := positive
+	if sign != "" {
+		format = negative
+		if !number.negative && !strings.Contains(negative, "{sign}") {
+			format = "{sign}" + positive
+		}
+	}
+
 	// Применяем формат валюты
-	format := currencyData.Format
 	format = strings.ReplaceAll(format, "{symbol}", currencyDisplay)
 	format = strings.ReplaceAll(format, "{code}", f.options.Currency)
-	format = strings.ReplaceAll(format, "{number}", decimalStr)
+	format = strings.ReplaceAll(format, "{sign}", sign)
+	format = strings.ReplaceAll(format, "{number}", f.formatUnsigned(number))
 
 	return format
 }
 
+// currencyPatterns возвращает шаблоны положительной и отрицательной сумм
+// для записи знака sign. Валюта с собственным шаблоном Format получает знак перед ним.
+func (f *Formatter) currencyPatterns(currency *CurrencyData, sign CurrencySign) (positive, negative string) {
+	if currency.Format != f.locale.CurrencyPattern {
+		return currency.Format, "{sign}" + currency.Format
+	}
+	return f.locale.currencyPatterns(sign)
+}
+
+// currencyFormat возвращает данные валюты для форматирования и разбора
+func (f *Formatter) currencyFormat(code string) *CurrencyData {
+	if data, exists := f.locale.CurrencyFormats[code]; exists {
+		return data
+	}
+	if data, exists := currencyData[code]; exists {
+		if f.locale.CurrencyPattern == "" {
+			return data
+		}
+		// Валюта без локализованных данных выводится по шаблону локали
+		localized := *data
+		localized.Format = f.locale.CurrencyPattern
+		return &localized
+	}
+
+	// Неизвестная валюта выводится кодом перед числом
+	return &CurrencyData{Format: "{code}{number}"}
+}
+
 // formatPercent форматирует число как процент
-func (f *Formatter) formatPercent(number float64) string {
-	// Умножаем на 100 для процентов
-	percentNumber := number * 100
-	decimalStr := f.formatDecimal(percentNumber)
+func (f *Formatter) formatPercent(number decimal) string {
+	// Умножаем на 100 для процентов сдвигом запятой, без ошибок округления
+	percentNumber := number.shift(2)
+	decimalStr := f.formatNumber(percentNumber)
 
 	// Применяем шаблон процентов
 	format := f.locale.PercentPattern
@@ -150,84 +294,122 @@ func (f *Formatter) formatPercent(number float64) string {
 	return format
 }
 
-// formatScientific форматирует число в научной нотации
-func (f *Formatter) formatScientific(number float64) string {
-	if number == 0 {
-		return "0" + f.locale.Exponential + "0"
+// formatScientific форматирует число в научной нотации; порядок кратен step,
+// поэтому step 3 дает инженерную нотацию (1.23E6, 12.3E3)
+func (f *Formatter) formatScientific(number decimal, step int) string {
+	if number.isZero() {
+		return f.scientificDigits(number, step)
 	}
 
-	sign := f.getSign(number)
-	absNumber := math.Abs(number)
+	// Знак добавляется ко всей записи
+	return f.applySignPattern(f.scientificDigits(number, step), f.getSign(number))
+}
 
-	// Определяем экспоненту
-	exponent := 0
-	if absNumber >= 1 {
-		for absNumber >= 10 {
-			absNumber /= 10
-			exponent++
-		}
-	} else {
-		for absNumber < 1 && absNumber > 0 {
-			absNumber *= 10
-			exponent--
-		}
+// scientificDigits форматирует модуль числа в научной нотации без знака
+func (f *Formatter) scientificDigits(number decimal, step int) string {
+	if number.isZero() {
+		return f.numbering.transliterate("0") + f.formatExponent(0)
 	}
 
-	// Округляем мантиссу
-	mantissa := f.roundNumber(absNumber)
+	// Порядок известен точно; округляем его вниз до кратного step
+	exponent := number.exp - 1
+	exponent -= ((exponent % step) + step) % step
+
+	// Округляем мантиссу; 9.9995 может стать 10 (999.9995 - 1000), тогда сдвигаем порядок
+	mantissa := f.roundNumber(number.abs().shift(-exponent))
+	if mantissa.exp > step {
+		mantissa = mantissa.shift(-step)
+		exponent += step
+	}
 
 	// Форматируем мантиссу как десятичное число
-	mantissaStr := f.formatDecimal(mantissa)
+	return f.formatDigits(mantissa) + f.formatExponent(exponent)
+}
 
-	// Форматируем экспоненту без лишних нулей
-	exponentStr := strconv.Itoa(exponent)
-	if exponent >= 0 {
-		// Убираем + для положительной экспоненты
-		exponentStr = strconv.Itoa(exponent)
-	} else {
-		exponentStr = "-" + strconv.Itoa(-exponent)
+// superscriptDigits содержит надстрочные цифры 0-9 для записи ×10ⁿ
+var superscriptDigits = [10]string{"⁰", "¹", "²", "³", "⁴", "⁵", "⁶", "⁷", "⁸", "⁹"}
+
+// formatExponent форматирует порядок вместе с разделителем мантиссы
+// ("E6", "e-5", "×10⁶", "×10^6")
+func (f *Formatter) formatExponent(exponent int) string {
+	display := f.options.ExponentDisplay
+	if display == ExponentAuto {
+		display = ExponentE
+		if f.locale.SuperscriptingExponent {
+			display = ExponentSuperscript
+		}
 	}
 
-	result := mantissaStr + f.locale.Exponential + exponentStr
-	return f.applySignPattern(result, sign)
+	digits := strconv.Itoa(exponent)
+	if exponent < 0 {
+		digits = digits[1:]
+	}
+	if len(digits) < f.options.MinimumExponentDigits {
+		digits = strings.Repeat("0", f.options.MinimumExponentDigits-len(digits)) + digits
+	}
+
+	sign := ""
+	if exponent < 0 {
+		sign = f.locale.MinusSign
+	} else if f.options.ExponentSignAlways {
+		sign = f.locale.PlusSign
+	}
+
+	if display != ExponentSuperscript {
+		digits = f.numbering.transliterate(digits)
+	}
+
+	switch display {
+	case ExponentLowerE:
+		return "e" + sign + digits
+	case ExponentSuperscript:
+		var result strings.Builder
+		result.WriteString("×10")
+		switch {
+		case exponent < 0:
+			result.WriteString("⁻")
+		case f.options.ExponentSignAlways:
+			result.WriteString("⁺")
+		}
+		for i := 0; i < len(digits); i++ {
+			result.WriteString(superscriptDigits[digits[i]-'0'])
+		}
+		return result.String()
+	case ExponentCaret:
+		return "×10^" + sign + digits
+	default:
+		return f.locale.Exponential + sign + digits
+	}
 }
 
 // formatCompact форматирует число в компактной записи
-func (f *Formatter) formatCompact(number float64) string {
-	absNumber := math.Abs(number)
-	sign := f.getSign(number)
+func (f *Formatter) formatCompact(number decimal) string {
+	if number.isZero() {
+		return f.formatDecimal(number)
+	}
 
-	// Определяем диапазон
-	var rangeType CompactRange
-	var divisor float64
-
-	switch {
-	case absNumber >= 1e12:
-		rangeType = Trillion
-		divisor = 1e12
-	case absNumber >= 1e9:
-		rangeType = Billion
-		divisor = 1e9
-	case absNumber >= 1e6:
-		rangeType = Million
-		divisor = 1e6
-	case absNumber >= 1e3:
-		rangeType = Thousand
-		divisor = 1e3
-	default:
+	// Определяем диапазон по порядку числа
+	rangeType, ok := f.compactRangeFor(number.exp - 1)
+	exponent := 0
+	if ok {
+		exponent = compactExponent(rangeType)
+	}
+
+	// Округление может добавить разряд (999999.9999 - 1000K), тогда выбираем
+	// диапазон заново по новому порядку, как в scientificDigits
+	if rounded := f.roundNumber(number.abs().shift(-exponent)); rounded.exp+exponent > number.exp {
+		rangeType, ok = f.compactRangeFor(number.exp)
+	}
+	if !ok {
 		// Число слишком маленькое для компактной записи
 		return f.formatDecimal(number)
 	}
 
-	// Вычисляем компактное значение
-	compactValue := number / divisor
-	absCompactValue := math.Abs(compactValue)
-
-	// Округляем до нужной точности
-	roundedValue := f.roundNumber(absCompactValue)
+	sign := f.getSign(number)
 
-	// Форматируем число
-	numberStr := f.formatDecimal(roundedValue)
+	// Вычисляем компактное значение сдвигом запятой и форматируем его
+	compactValue := number.abs().shift(-compactExponent(rangeType))
+	numberStr := f.formatDigits(compactValue)
 
 	// Получаем шаблон для компактной записи
 	pattern := f.getCompactPattern(rangeType)
@@ -238,6 +420,24 @@ func (f *Formatter) formatCompact(number float64) string {
 	return f.applySignPattern(result, sign)
 }
 
+// compactRangeFor возвращает наибольший из диапазонов компактной записи локали
+// с шаблоном для текущего вида, не превышающий порядок числа
+// (количество цифр целой части минус один)
+func (f *Formatter) compactRangeFor(magnitude int) (CompactRange, bool) {
+	best, found := CompactRange(0), false
+	for rangeType := range f.locale.CompactPatterns {
+		if f.getCompactPattern(rangeType) == "" {
+			// Пустой шаблон: в этом виде записи диапазон не сокращается
+			continue
+		}
+		exponent := compactExponent(rangeType)
+		if exponent <= magnitude && (!found || exponent > compactExponent(best)) {
+			best, found = rangeType, true
+		}
+	}
+	return best, found
+}
+
 // getCompactPattern возвращает шаблон для компактной записи
 func (f *Formatter) getCompactPattern(rangeType CompactRange) string {
 	patternData, exists := f.locale.CompactPatterns[rangeType]
@@ -252,19 +452,27 @@ func (f *Formatter) getCompactPattern(rangeType CompactRange) string {
 }
 
 // getSign возвращает знак числа и как его отображать
-func (f *Formatter) getSign(number float64) string {
-	if number < 0 {
+func (f *Formatter) getSign(number decimal) string {
+	// SignExceptZero не ставит знак у числа, которое выводится нулем:
+	// -0.001 с двумя знаками дает "0", а не "-0"
+	if f.options.SignDisplay == SignExceptZero {
+		zero := number.isZero()
+		if f.options.Notation == Standard {
+			zero = f.roundNumber(number).isZero()
+		}
+		if zero {
+			return ""
+		}
+	}
+
+	if number.negative {
 		return f.locale.MinusSign
 	}
 
-	// Для положительных чисел и нуля
+	// Для положительных чисел
 	switch f.options.SignDisplay {
-	case SignAlways:
+	case SignAlways, SignExceptZero:
 		return f.locale.PlusSign
-	case SignExceptZero:
-		if number > 0 {
-			return f.locale.PlusSign
-		}
 	}
 
 	return ""
@@ -292,96 +500,21 @@ func (f *Formatter) applySignPattern(numberStr, sign string) string {
 	return result
 }
 
-// roundNumber округляет число согласно настройкам
-func (f *Formatter) roundNumber(number float64) float64 {
-	// Для очень маленьких чисел возвращаем как есть
-	if number == 0 || math.Abs(number) < 1e-324 {
-		return number
-	}
-
-	scale := math.Pow10(f.options.MaximumFractionDigits)
-	var rounded float64
-
-	switch f.options.RoundingMode {
-	case RoundHalfUp:
-		rounded = math.Round(number*scale) / scale
-	case RoundHalfDown:
-		rounded = f.roundHalfDown(number, scale)
-	case RoundHalfEven:
-		rounded = f.roundHalfEven(number, scale)
-	case RoundCeiling:
-		rounded = math.Ceil(number*scale) / scale
-	case RoundFloor:
-		rounded = math.Floor(number*scale) / scale
-	case RoundUp:
-		rounded = f.roundUp(number, scale)
-	case RoundDown:
-		rounded = f.roundDown(number, scale)
-	default:
-		rounded = math.Round(number*scale) / scale
-	}
-
-	return rounded
-}
-
-// Вспомогательные методы для округления
-func (f *Formatter) roundHalfDown(number, scale float64) float64 {
-	scaled := number * scale
-	floor := math.Floor(scaled)
-	ceil := math.Ceil(scaled)
-
-	if scaled-floor > 0.5 {
-		return ceil / scale
-	}
-	return floor / scale
+// roundNumber округляет число до MaximumFractionDigits по цифрам его
+// десятичной записи, поэтому 1.005 с RoundHalfUp дает 1.01, а не 1.00
+func (f *Formatter) roundNumber(number decimal) decimal {
+	return number.round(f.options.MaximumFractionDigits, f.options.RoundingMode)
 }
 
-func (f *Formatter) roundHalfEven(number, scale float64) float64 {
-	scaled := number * scale
-	floor := math.Floor(scaled)
-	ceil := math.Ceil(scaled)
-
-	if scaled-floor == 0.5 {
-		// Банковское округление: к ближайшему четному
-		if int64(floor)%2 == 0 {
-			return floor / scale
-		}
-		return ceil / scale
-	}
-
-	if scaled-floor > 0.5 {
-		return ceil / scale
-	}
-	return floor / scale
-}
-
-func (f *Formatter) roundUp(number, scale float64) float64 {
-	if number > 0 {
-		return math.Ceil(number*scale) / scale
-	}
-	return math.Floor(number*scale) / scale
-}
-
-func (f *Formatter) roundDown(number, scale float64) float64 {
-	if number > 0 {
-		return math.Floor(number*scale) / scale
-	}
-	return math.Ceil(number*scale) / scale
-}
-
-// splitNumber разделяет число на целую и дробную части
-func (f *Formatter) splitNumber(number float64) (string, string) {
-	// Используем точное строковое представление для избежания ошибок округления
-	str := strconv.FormatFloat(number, 'f', -1, 64)
-
-	parts := strings.Split(str, ".")
-	intPart := parts[0]
-	fracPart := ""
-	if len(parts) > 1 {
-		fracPart = parts[1]
+// roundIncrement округляет значение до кратного шагу округления, если он
+// задан. Шаг относится к самому числу, поэтому действует только в обычной
+// записи стилей Decimal и Currency; мантисса научной нотации, сокращенное
+// значение и проценты округляются лишь по числу знаков.
+func (f *Formatter) roundIncrement(number decimal) decimal {
+	if f.increment.isZero() || f.options.Notation != Standard {
+		return number
 	}
-
-	return intPart, fracPart
+	return number.roundIncrement(f.increment, f.options.RoundingMode)
 }
 
 // formatIntegerPart форматирует целую часть числа
@@ -391,10 +524,6 @@ func (f *Formatter) formatIntegerPart(intPart string) string {
 		intPart = "0" + intPart
 	}
 
-	if !f.options.UseGrouping {
-		return intPart
-	}
-
 	return f.applyGrouping(intPart)
 }
 
@@ -422,24 +551,71 @@ func (f *Formatter) formatFractionalPart(fracPart string) string {
 
 // applyGrouping применяет группировку цифр
 func (f *Formatter) applyGrouping(number string) string {
-	if len(number) <= 3 {
+	sizes := f.groupSizes(len(number))
+	if len(sizes) == 1 {
 		return number
 	}
 
 	var result strings.Builder
-	groupSize := 3
-	firstGroupSize := len(number) % groupSize
+	pos := 0
+	for i, size := range sizes {
+		if i > 0 {
+			result.WriteString(f.locale.GroupSeparator)
+		}
+		result.WriteString(number[pos : pos+size])
+		pos += size
+	}
+
+	return result.String()
+}
 
-	if firstGroupSize == 0 {
-		firstGroupSize = groupSize
+// groupSizes возвращает длины групп слева направо для целой части из digits цифр:
+// справа стоит основная группа, левее - вторичные (12,34,567 для 2 и 3).
+// Если по стратегии группировки число не группируется, возвращается одна группа.
+func (f *Formatter) groupSizes(digits int) []int {
+	primary, secondary := f.groupingSizes()
+	if f.options.Grouping == GroupingOff || digits < primary+f.minimumGroupingDigits() {
+		return []int{digits}
 	}
 
-	result.WriteString(number[:firstGroupSize])
+	sizes := []int{primary}
+	rest := digits - primary
+	for rest > secondary {
+		sizes = append(sizes, secondary)
+		rest -= secondary
+	}
+	sizes = append(sizes, rest)
 
-	for i := firstGroupSize; i < len(number); i += groupSize {
-		result.WriteString(f.locale.GroupSeparator)
-		result.WriteString(number[i : i+groupSize])
+	// Разворачиваем, чтобы группы шли слева направо
+	for i, j := 0, len(sizes)-1; i < j; i, j = i+1, j-1 {
+		sizes[i], sizes[j] = sizes[j], sizes[i]
 	}
+	return sizes
+}
 
-	return result.String()
+// minimumGroupingDigits возвращает минимум цифр в старшей группе по стратегии группировки
+func (f *Formatter) minimumGroupingDigits() int {
+	switch f.options.Grouping {
+	case GroupingAlways:
+		return 1
+	case GroupingMin2:
+		return 2
+	}
+	if f.locale.MinimumGroupingDigits > 0 {
+		return f.locale.MinimumGroupingDigits
+	}
+	return 1
+}
+
+// groupingSizes возвращает основной и вторичный размеры групп локали
+func (f *Formatter) groupingSizes() (primary, secondary int) {
+	primary = f.locale.PrimaryGroupSize
+	if primary <= 0 {
+		primary = 3
+	}
+	secondary = f.locale.SecondaryGroupSize
+	if secondary <= 0 {
+		secondary = primary
+	}
+	return primary, secondary
 }
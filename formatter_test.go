@@ -1,7 +1,12 @@
+//go:build !gonumfmt_minimal
+
 package gonumfmt
 
 import (
+	"errors"
 	"math"
+	"math/big"
+	"strings"
 	"testing"
 )
 
@@ -54,7 +59,7 @@ func TestFormatter_Currency(t *testing.T) {
 		{"EUR German", 99.99, "de", "EUR", "99,99 €"},
 		{"RUB Russian", 1234.56, "ru", "RUB", "1 234,56 ₽"},
 		{"GBP English", 1234.56, "en", "GBP", "£1,234.56"},
-		{"JPY Japanese", 1234.56, "ja", "JPY", "¥1,234.56"},
+		{"JPY Japanese", 1234.56, "ja", "JPY", "￥1,235"},
 		{"CNY Chinese", 1234.56, "zh", "CNY", "¥1,234.56"},
 		{"Unknown Currency", 123.45, "en", "XYZ", "XYZ123.45"},
 	}
@@ -106,6 +111,102 @@ func TestFormatter_Percent(t *testing.T) {
 	}
 }
 
+func TestFormatter_IndianGrouping(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   float64
+		locale   string
+		expected string
+	}{
+		{"Thousands", 1234, "en-IN", "1,234"},
+		{"Lakh", 123456, "en-IN", "1,23,456"},
+		{"Ten lakh", 1234567, "en-IN", "12,34,567"},
+		{"Crore with fraction", 123456789.5, "en-IN", "12,34,56,789.5"},
+		{"Hindi", 1234567, "hi", "12,34,567"},
+		{"Negative", -1234567, "hi", "-12,34,567"},
+		{"Small", 999, "hi", "999"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewFormatter(WithLocale(tt.locale)).Format(tt.number)
+			if result != tt.expected {
+				t.Errorf("Grouping format(%f) in %s = %s, expected %s",
+					tt.number, tt.locale, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatter_GroupingStrategy(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   float64
+		locale   string
+		strategy GroupingStrategy
+		expected string
+	}{
+		{"Spanish four digits", 1234, "es", GroupingAuto, "1234"},
+		{"Spanish five digits", 12345, "es", GroupingAuto, "12.345"},
+		{"Spanish always", 1234, "es", GroupingAlways, "1.234"},
+		{"Polish four digits", 1234, "pl", GroupingAuto, "1234"},
+		{"Polish five digits", 12345.5, "pl", GroupingAuto, "12 345,5"},
+		{"English auto", 1234, "en", GroupingAuto, "1,234"},
+		{"English min2", 1234, "en", GroupingMin2, "1234"},
+		{"English min2 five digits", 12345, "en", GroupingMin2, "12,345"},
+		{"English off", 1234567, "en", GroupingOff, "1234567"},
+		{"Swiss German", 1234567.5, "de-CH", GroupingAuto, "1’234’567.5"},
+		{"Indian min2", 1234, "hi", GroupingMin2, "1234"},
+		{"Indian min2 lakh", 123456, "hi", GroupingMin2, "1,23,456"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFormatter(WithLocale(tt.locale), WithGroupingStrategy(tt.strategy))
+			result := f.Format(tt.number)
+			if result != tt.expected {
+				t.Errorf("Grouping strategy %d format(%f) in %s = %s, expected %s",
+					tt.strategy, tt.number, tt.locale, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatter_GroupSizeOfFour(t *testing.T) {
+	locale := *GetLocaleData("zh")
+	locale.PrimaryGroupSize = 4
+
+	f := NewFormatter(WithLocale("zh"))
+	f.locale = &locale
+
+	if result := f.Format(123456789); result != "1,2345,6789" {
+		t.Errorf("Group size 4 format = %s, expected 1,2345,6789", result)
+	}
+	if value, err := f.Parse("1,2345,6789"); err != nil || value != 123456789 {
+		t.Errorf("Group size 4 parse = %v, %v, expected 123456789", value, err)
+	}
+}
+
+func TestFormatter_CompactLong(t *testing.T) {
+	tests := []struct {
+		number   float64
+		locale   string
+		expected string
+	}{
+		{120000, "en-IN", "1.2 lakh"},
+		{34000000, "en-IN", "3.4 crore"},
+		{34000000, "hi", "3.4 करोड़"},
+	}
+
+	for _, tt := range tests {
+		result := NewFormatter(WithLocale(tt.locale), WithCompactDisplay(Long)).Format(tt.number)
+		if result != tt.expected {
+			t.Errorf("Compact long format(%f) in %s = %s, expected %s",
+				tt.number, tt.locale, result, tt.expected)
+		}
+	}
+}
+
 func TestFormatter_Compact(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -123,9 +224,30 @@ func TestFormatter_Compact(t *testing.T) {
 		{"Russian trillion", 1500000000000.0, "ru", "1,5 трлн"},
 		{"German million", 1500000.0, "de", "1,5 Mio."},
 		{"French million", 1500000.0, "fr", "1,5 M"},
-		{"Japanese million", 1500000.0, "ja", "1.5百万"},
-		{"Chinese million", 1500000.0, "zh", "1.5百万"},
+		{"Japanese million", 1500000.0, "ja", "150万"},
+		{"Japanese hundred million", 123456789, "ja", "1.235億"},
+		{"Chinese million", 1500000.0, "zh", "150万"},
+		{"Chinese hundred million", 123456789, "zh", "1.235亿"},
 		{"Small number compact", 999.0, "en", "999"},
+		{"Rounding carries into next range", 999999.9999, "en", "1M"},
+		{"Rounding carries into first range", 999.9999, "en", "1K"},
+		{"Rounding carries into lakh", 99999.9999, "en-IN", "1L"},
+		{"Negative rounding carry", -999999999.9999, "ru", "-1 млрд"},
+		{"Indian English thousand", 12000.0, "en-IN", "12K"},
+		{"Indian English lakh", 120000.0, "en-IN", "1.2L"},
+		{"Indian English lakhs", 1200000.0, "en-IN", "12L"},
+		{"Indian English crore", 34000000.0, "en-IN", "3.4Cr"},
+		{"Indian English lakh crore", 1.2e12, "en-IN", "1.2LCr"},
+		{"Hindi lakh", 120000.0, "hi", "1.2 लाख"},
+		{"Hindi crore", 34000000.0, "hi", "3.4 क॰"},
+		{"Hindi arab", 1500000000.0, "hi", "1.5 अ॰"},
+		{"Hindi kharab", 250000000000.0, "hi", "2.5 ख॰"},
+		{"German thousand not abbreviated", 1500.0, "de", "1.500"},
+		{"Spanish hundreds of millions", 150000000.0, "es", "150 M"},
+		{"Spanish thousand millions", 15000000000.0, "es", "15 mil M"},
+		{"Korean man", 15000.0, "ko", "1.5만"},
+		{"Korean eok", 150000000.0, "ko", "1.5억"},
+		{"Swahili prefix pattern", 1500000.0, "sw", "M1.5"},
 	}
 
 	for _, tt := range tests {
@@ -192,9 +314,11 @@ func TestFormatter_SignDisplay(t *testing.T) {
 		{"Never positive", 123.45, SignNever, "123.45"},
 		{"Never negative", -123.45, SignNever, "123.45"},
 		{"Never zero", 0.0, SignNever, "0"},
-		{"ExceptZero positive", 123.45, SignExceptZero, "123.45"},
+		{"ExceptZero positive", 123.45, SignExceptZero, "+123.45"},
 		{"ExceptZero negative", -123.45, SignExceptZero, "-123.45"},
 		{"ExceptZero zero", 0.0, SignExceptZero, "0"},
+		{"ExceptZero negative rounded to zero", -0.0001, SignExceptZero, "0"},
+		{"ExceptZero positive rounded to zero", 0.0001, SignExceptZero, "0"},
 	}
 
 	for _, tt := range tests {
@@ -237,6 +361,96 @@ func TestFormatter_Scientific(t *testing.T) {
 	}
 }
 
+func TestFormatter_Notation(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   float64
+		options  []FormatterOption
+		expected string
+	}{
+		{"Engineering decimal", 1234567.89, []FormatterOption{WithNotation(Engineering)}, "1.235E6"},
+		{"Engineering tens", 12345, []FormatterOption{WithNotation(Engineering)}, "12.345E3"},
+		{"Engineering hundreds", 123456, []FormatterOption{WithNotation(Engineering)}, "123.456E3"},
+		{"Engineering small", 0.000123, []FormatterOption{WithNotation(Engineering)}, "123E-6"},
+		{"Engineering negative", -0.0123, []FormatterOption{WithNotation(Engineering)}, "-12.3E-3"},
+		{"Engineering carry", 999999.9, []FormatterOption{WithNotation(Engineering), WithPrecision(0, 0)}, "1E6"},
+		{"Engineering style", 1234567, []FormatterOption{WithStyle(Scientific), WithNotation(Engineering)}, "1.235E6"},
+		{"Scientific notation decimal", 1234567, []FormatterOption{WithNotation(ScientificNotation)}, "1.235E6"},
+		{"Scientific currency", 1230000, []FormatterOption{WithLocale("de"), WithStyle(Currency), WithCurrency("EUR"),
+			WithNotation(ScientificNotation)}, "1,23E6 €"},
+		{"Scientific currency en", 1230000, []FormatterOption{WithStyle(Currency), WithCurrency("USD"),
+			WithNotation(ScientificNotation)}, "$1.23E6"},
+		{"Engineering percent", 123, []FormatterOption{WithStyle(Percent), WithNotation(Engineering)}, "12.3E3%"},
+		{"Scientific percent", 0.5, []FormatterOption{WithStyle(Percent), WithNotation(ScientificNotation)}, "5E1%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFormatter(append([]FormatterOption{WithLocale("en")}, tt.options...)...)
+			result := f.Format(tt.number)
+			if result != tt.expected {
+				t.Errorf("Notation format(%f) = %s, expected %s",
+					tt.number, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatter_ExponentDisplay(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   float64
+		options  []FormatterOption
+		expected string
+	}{
+		{"Locale default", 1230000, nil, "1.23E6"},
+		{"Lowercase", 1230000, []FormatterOption{WithExponentDisplay(ExponentLowerE)}, "1.23e6"},
+		{"Superscript", 1230000, []FormatterOption{WithExponentDisplay(ExponentSuperscript)}, "1.23×10⁶"},
+		{"Superscript negative", 0.000123, []FormatterOption{WithExponentDisplay(ExponentSuperscript)}, "1.23×10⁻⁴"},
+		{"Superscript two digits", 1.5e12, []FormatterOption{WithExponentDisplay(ExponentSuperscript)}, "1.5×10¹²"},
+		{"Caret", 1230000, []FormatterOption{WithExponentDisplay(ExponentCaret)}, "1.23×10^6"},
+		{"Caret negative", 0.000123, []FormatterOption{WithExponentDisplay(ExponentCaret)}, "1.23×10^-4"},
+		{"Minimum digits", 1230000, []FormatterOption{WithExponentDigits(2)}, "1.23E06"},
+		{"Minimum digits negative", 0.000123, []FormatterOption{WithExponentDigits(3)}, "1.23E-004"},
+		{"Sign always", 1230000, []FormatterOption{WithExponentSign(true)}, "1.23E+6"},
+		{"Sign always negative", 0.000123, []FormatterOption{WithExponentSign(true)}, "1.23E-4"},
+		{"Sign always zero", 1.5, []FormatterOption{WithExponentSign(true), WithExponentDigits(2)}, "1.5E+00"},
+		{"Superscript sign always", 1230000, []FormatterOption{WithExponentDisplay(ExponentSuperscript),
+			WithExponentSign(true)}, "1.23×10⁺⁶"},
+		{"Zero", 0, []FormatterOption{WithExponentDisplay(ExponentSuperscript)}, "0×10⁰"},
+		{"Engineering superscript", 12300, []FormatterOption{WithNotation(Engineering),
+			WithExponentDisplay(ExponentSuperscript)}, "12.3×10³"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]FormatterOption{WithLocale("en"), WithStyle(Scientific)}, tt.options...)
+			result := NewFormatter(opts...).Format(tt.number)
+			if result != tt.expected {
+				t.Errorf("Exponent format(%f) = %s, expected %s",
+					tt.number, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatter_ExponentLocaleSuperscript(t *testing.T) {
+	locale := *GetLocaleData("en")
+	locale.SuperscriptingExponent = true
+
+	f := NewFormatter(WithLocale("en"), WithStyle(Scientific))
+	f.locale = &locale
+
+	if result := f.Format(1230000); result != "1.23×10⁶" {
+		t.Errorf("Superscripting locale format = %s, expected 1.23×10⁶", result)
+	}
+
+	f.options.ExponentDisplay = ExponentE
+	if result := f.Format(1230000); result != "1.23E6" {
+		t.Errorf("Overridden superscripting locale format = %s, expected 1.23E6", result)
+	}
+}
+
 func TestFormatter_VerySmallNumbers(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -326,7 +540,12 @@ func TestCurrencyFormats(t *testing.T) {
 		{"USD Name US", 1234.56, "en", "USD", CurrencyName, "US Dollar1,234.56"},
 		{"EUR Symbol DE", 99.99, "de", "EUR", CurrencySymbol, "99,99 €"},
 		{"RUB Symbol RU", 1234.56, "ru", "RUB", CurrencySymbol, "1 234,56 ₽"},
-		{"JPY Symbol JP", 1234.56, "ja", "JPY", CurrencySymbol, "¥1,234.56"},
+		{"JPY Symbol JP", 1234.56, "ja", "JPY", CurrencySymbol, "￥1,235"},
+		{"CHF Symbol DE-CH", 1234.56, "de-CH", "CHF", CurrencySymbol, "CHF 1’234.56"},
+		{"PLN Symbol PL", 1234.56, "pl", "PLN", CurrencySymbol, "1234,56 zł"},
+		{"BRL Symbol PT", 1234.56, "pt", "BRL", CurrencySymbol, "R$ 1.234,56"},
+		{"TRY Symbol TR", 1234.56, "tr", "TRY", CurrencySymbol, "₺1.234,56"},
+		{"Global currency with locale pattern", 1234.56, "de", "SGD", CurrencySymbol, "1.234,56 SGD"},
 		{"Unknown Currency", 123.45, "en", "XYZ", CurrencySymbol, "XYZ123.45"},
 	}
 
@@ -345,6 +564,98 @@ func TestCurrencyFormats(t *testing.T) {
 	}
 }
 
+func TestCurrencyMinorUnits(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   float64
+		opts     []FormatterOption
+		expected string
+	}{
+		{"USD two digits", 1.5, []FormatterOption{WithCurrency("USD")}, "$1.50"},
+		{"JPY no digits", 1234.56, []FormatterOption{WithCurrency("JPY")}, "¥1,235"},
+		{"KWD three digits", 1.5, []FormatterOption{WithCurrency("KWD")}, "KWD1.500"},
+		{"CLF four digits", 1.5, []FormatterOption{WithCurrency("CLF")}, "CLF1.5000"},
+		{"EUR in de", 1234.5, []FormatterOption{WithLocale("de"), WithCurrency("EUR")}, "1.234,50 €"},
+		{"Unknown currency", 1.5, []FormatterOption{WithCurrency("XYZ")}, "XYZ1.50"},
+		{"Explicit precision", 1.5, []FormatterOption{WithPrecision(0, 3), WithCurrency("USD")}, "$1.5"},
+		{"Explicit fixed precision", 1234.5, []FormatterOption{WithCurrency("JPY"), WithFixedPrecision(1)}, "¥1,234.5"},
+		{"Explicit zero removal", 1.5, []FormatterOption{WithCurrency("USD"), WithTrailingZeroRemoval(true)}, "$1.5"},
+		{"Decimal style unchanged", 1.5, []FormatterOption{WithCurrency("USD"), WithStyle(Decimal)}, "1.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]FormatterOption{WithLocale("en")}, tt.opts...)
+			if result := NewFormatter(opts...).Format(tt.number); result != tt.expected {
+				t.Errorf("Format(%v) = %q, expected %q", tt.number, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCurrencySign(t *testing.T) {
+	accounting := WithCurrencySign(CurrencySignAccounting)
+
+	tests := []struct {
+		name     string
+		number   float64
+		opts     []FormatterOption
+		expected string
+	}{
+		{"Minus before symbol", -1234.56, []FormatterOption{WithCurrency("USD")}, "-$1,234.56"},
+		{"Accounting negative", -1234.56, []FormatterOption{WithCurrency("USD"), accounting}, "($1,234.56)"},
+		{"Accounting positive", 1234.56, []FormatterOption{WithCurrency("USD"), accounting}, "$1,234.56"},
+		{"Accounting plus", 5, []FormatterOption{WithCurrency("USD"), accounting, WithSignDisplay(SignAlways)}, "+$5.00"},
+		{"Accounting sign never", -5, []FormatterOption{WithCurrency("USD"), accounting, WithSignDisplay(SignNever)}, "$5.00"},
+		{"Plus before symbol", 5, []FormatterOption{WithCurrency("USD"), WithSignDisplay(SignAlways)}, "+$5.00"},
+		{"Minus after symbol in nl", -5, []FormatterOption{WithLocale("nl"), WithCurrency("EUR")}, "€ -5,00"},
+		{"Minus after symbol in de-CH", -5, []FormatterOption{WithLocale("de-CH"), WithCurrency("CHF")}, "CHF-5.00"},
+		{"Plus after symbol in de-CH", 5, []FormatterOption{WithLocale("de-CH"), WithCurrency("CHF"), WithSignDisplay(SignAlways)}, "CHF+5.00"},
+		{"Accounting in nl", -5, []FormatterOption{WithLocale("nl"), WithCurrency("EUR"), accounting}, "(€ 5,00)"},
+		{"Accounting in fr", -5, []FormatterOption{WithLocale("fr"), WithCurrency("EUR"), accounting}, "(5,00 €)"},
+		{"No accounting format in de", -5, []FormatterOption{WithLocale("de"), WithCurrency("EUR"), accounting}, "-5,00 €"},
+		{"Currency code", -5, []FormatterOption{WithCurrency("EUR"), WithCurrencyDisplay(CurrencyCode), accounting}, "(EUR5.00)"},
+		{"Unknown currency", -5, []FormatterOption{WithCurrency("XYZ"), accounting}, "-XYZ5.00"},
+		{"Scientific", -1234.5, []FormatterOption{WithCurrency("USD"), accounting, WithNotation(ScientificNotation)}, "($1.23E3)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]FormatterOption{WithLocale("en")}, tt.opts...)
+			if result := NewFormatter(opts...).Format(tt.number); result != tt.expected {
+				t.Errorf("Format(%v) = %q, expected %q", tt.number, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGeneratedData(t *testing.T) {
+	localeData := locales.Load().data
+	if len(localeData) < 50 {
+		t.Errorf("localeData has %d locales, expected at least 50", len(localeData))
+	}
+	if len(currencyData) < 150 {
+		t.Errorf("currencyData has %d currencies, expected at least 150", len(currencyData))
+	}
+
+	for locale, data := range localeData {
+		// У корневой локали нет региона, а значит и валюты по умолчанию
+		if _, ok := currencyData[data.DefaultCurrency]; !ok && locale != rootLocale {
+			t.Errorf("locale %s: unknown default currency %q", locale, data.DefaultCurrency)
+		}
+		if data.CurrencyPattern == "" || !strings.Contains(data.CurrencyPattern, "{number}") {
+			t.Errorf("locale %s: bad currency pattern %q", locale, data.CurrencyPattern)
+		}
+	}
+
+	digits := map[string]int{"USD": 2, "JPY": 0, "KWD": 3, "CLF": 4}
+	for code, expected := range digits {
+		if got := currencyData[code].Digits; got != expected {
+			t.Errorf("%s digits = %d, expected %d", code, got, expected)
+		}
+	}
+}
+
 func TestCompactPrecision(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -482,3 +793,115 @@ func TestPercentFormatting(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatter_BigNumbers(t *testing.T) {
+	bigInt, _ := new(big.Int).SetString("1180591620717411303424", 10)
+	negativeBigInt := new(big.Int).Neg(bigInt)
+	bigFloat, _ := new(big.Float).SetPrec(200).SetString("12345678901234567890.123456789")
+
+	tests := []struct {
+		name     string
+		format   func(f *Formatter) string
+		options  []FormatterOption
+		expected string
+	}{
+		{"BigInt English", func(f *Formatter) string { return f.FormatBigInt(bigInt) },
+			[]FormatterOption{WithLocale("en")}, "1,180,591,620,717,411,303,424"},
+		{"BigInt German negative", func(f *Formatter) string { return f.FormatBigInt(negativeBigInt) },
+			[]FormatterOption{WithLocale("de")}, "-1.180.591.620.717.411.303.424"},
+		{"BigInt currency", func(f *Formatter) string { return f.FormatBigInt(bigInt) },
+			[]FormatterOption{WithLocale("en"), WithCurrency("USD")}, "$1,180,591,620,717,411,303,424.00"},
+		{"BigInt percent", func(f *Formatter) string { return f.FormatBigInt(big.NewInt(5)) },
+			[]FormatterOption{WithLocale("en"), WithStyle(Percent)}, "500%"},
+		{"BigInt compact", func(f *Formatter) string { return f.FormatBigInt(bigInt) },
+			[]FormatterOption{WithLocale("en"), WithStyle(Compact)}, "1,180,591,620.717T"},
+		{"BigInt scientific", func(f *Formatter) string { return f.FormatBigInt(bigInt) },
+			[]FormatterOption{WithLocale("en"), WithStyle(Scientific), WithPrecision(2, 8)}, "1.18059162E21"},
+		{"BigFloat all digits", func(f *Formatter) string { return f.FormatBigFloat(bigFloat) },
+			[]FormatterOption{WithLocale("en"), WithPrecision(0, 9)}, "12,345,678,901,234,567,890.123456789"},
+		{"BigFloat infinity", func(f *Formatter) string { return f.FormatBigFloat(new(big.Float).SetInf(false)) },
+			[]FormatterOption{WithLocale("en")}, "∞"},
+		{"BigRat repeating", func(f *Formatter) string { return f.FormatBigRat(big.NewRat(2, 3)) },
+			[]FormatterOption{WithLocale("en")}, "0.667"},
+		{"BigRat half even tie", func(f *Formatter) string { return f.FormatBigRat(big.NewRat(1, 8)) },
+			[]FormatterOption{WithLocale("en"), WithPrecision(0, 2)}, "0.12"},
+		{"BigRat half up tie", func(f *Formatter) string { return f.FormatBigRat(big.NewRat(1, 8)) },
+			[]FormatterOption{WithLocale("en"), WithPrecision(0, 2), WithRoundingMode(RoundHalfUp)}, "0.13"},
+		{"BigRat floor negative", func(f *Formatter) string { return f.FormatBigRat(big.NewRat(-1, 3)) },
+			[]FormatterOption{WithLocale("en"), WithRoundingMode(RoundFloor)}, "-0.334"},
+		{"BigRat percent", func(f *Formatter) string { return f.FormatBigRat(big.NewRat(1, 3)) },
+			[]FormatterOption{WithLocale("en"), WithStyle(Percent), WithPrecision(0, 1)}, "33.3%"},
+		{"BigRat scientific", func(f *Formatter) string { return f.FormatBigRat(big.NewRat(1, 3000)) },
+			[]FormatterOption{WithLocale("en"), WithStyle(Scientific), WithPrecision(2, 2)}, "3.33E-4"},
+		{"Nil BigInt", func(f *Formatter) string { return f.FormatBigInt(nil) },
+			[]FormatterOption{WithLocale("en")}, "NaN"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.format(NewFormatter(tt.options...))
+			if result != tt.expected {
+				t.Errorf("%s = %s, expected %s", tt.name, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatter_DecimalString(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		options  []FormatterOption
+		expected string
+	}{
+		{"Trailing zeros trimmed", "12345.678900", []FormatterOption{WithLocale("en")}, "12,345.679"},
+		{"Fixed precision", "12345.678900", []FormatterOption{WithLocale("de"), WithFixedPrecision(2)}, "12.345,68"},
+		{"Exact tie half up", "1.005", []FormatterOption{WithPrecision(0, 2), WithRoundingMode(RoundHalfUp)}, "1.01"},
+		{"Exact tie half even", "1.005", []FormatterOption{WithPrecision(0, 2), WithRoundingMode(RoundHalfEven)}, "1"},
+		{"Beyond float64", "123456789012345678901234.5", []FormatterOption{WithPrecision(1, 1)}, "123,456,789,012,345,678,901,234.5"},
+		{"Negative", "-0.125", []FormatterOption{WithPrecision(0, 2), WithRoundingMode(RoundFloor)}, "-0.13"},
+		{"Leading plus and zeros", "+000042", nil, "42"},
+		{"No integer digits", ".5", []FormatterOption{WithStyle(Percent)}, "50%"},
+		{"Currency", "-1234.5", []FormatterOption{WithLocale("ru"), WithCurrency("RUB"), WithFixedPrecision(2), WithTrailingZeroRemoval(false)}, "-1 234,50 ₽"},
+		{"NaN", "NaN", nil, "NaN"},
+		{"Infinity", "-Infinity", nil, "-∞"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]FormatterOption{WithLocale("en")}, tt.options...)
+			result, err := NewFormatter(opts...).FormatDecimalString(tt.input)
+			if err != nil {
+				t.Fatalf("FormatDecimalString(%q) returned error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("FormatDecimalString(%q) = %s, expected %s", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatter_DecimalStringErrors(t *testing.T) {
+	tests := []struct {
+		input  string
+		err    error
+		offset int
+	}{
+		{"", ErrEmptyInput, 0},
+		{"-", ErrNoDigits, 1},
+		{"12.34.5", ErrDuplicateDecimalSeparator, 5},
+		{"1,234.5", ErrUnexpectedCharacter, 1},
+		{"1e10", ErrUnexpectedCharacter, 1},
+		{" 12", ErrUnexpectedCharacter, 0},
+	}
+
+	f := NewFormatter(WithLocale("en"))
+	for _, tt := range tests {
+		_, err := f.FormatDecimalString(tt.input)
+		var parseErr *ParseError
+		if !errors.Is(err, tt.err) || !errors.As(err, &parseErr) || parseErr.Offset != tt.offset {
+			t.Errorf("FormatDecimalString(%q) error = %v, expected %v at offset %d",
+				tt.input, err, tt.err, tt.offset)
+		}
+	}
+}
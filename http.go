@@ -0,0 +1,144 @@
+package gonumfmt
+
+import (
+	"context"
+	"net/http"
+	"slices"
+)
+
+// LocaleSource задает, откуда middleware берет локаль запроса
+type LocaleSource int
+
+const (
+	SourceQuery  LocaleSource = iota // параметр запроса, по умолчанию "locale"
+	SourceCookie                     // cookie, по умолчанию "locale"
+	SourceHeader                     // заголовок Accept-Language
+)
+
+// middlewareConfig содержит настройки LocaleMiddleware
+type middlewareConfig struct {
+	sources    []LocaleSource
+	queryParam string
+	cookieName string
+	supported  []string
+	options    []FormatterOption
+}
+
+// MiddlewareOption настраивает LocaleMiddleware
+type MiddlewareOption func(*middlewareConfig)
+
+// WithLocaleSources задает порядок источников локали; по умолчанию
+// параметр запроса, cookie, затем Accept-Language
+func WithLocaleSources(sources ...LocaleSource) MiddlewareOption {
+	return func(c *middlewareConfig) {
+		c.sources = sources
+	}
+}
+
+// WithQueryParameter задает имя параметра запроса с локалью
+func WithQueryParameter(name string) MiddlewareOption {
+	return func(c *middlewareConfig) {
+		c.queryParam = name
+	}
+}
+
+// WithCookieName задает имя cookie с локалью
+func WithCookieName(name string) MiddlewareOption {
+	return func(c *middlewareConfig) {
+		c.cookieName = name
+	}
+}
+
+// WithSupportedLocales ограничивает выбор локалями приложения; первая
+// используется, если ни один источник не подошел (см. MatchLocale)
+func WithSupportedLocales(locales ...string) MiddlewareOption {
+	return func(c *middlewareConfig) {
+		c.supported = locales
+	}
+}
+
+// WithFormatterOptions задает опции форматтера запроса; локаль
+// определяется middleware
+func WithFormatterOptions(opts ...FormatterOption) MiddlewareOption {
+	return func(c *middlewareConfig) {
+		c.options = opts
+	}
+}
+
+// LocaleMiddleware возвращает middleware net/http, которое определяет
+// локаль запроса и кладет в его контекст форматтер для нее (см. FromContext).
+// Значения параметра запроса и cookie разбираются как Accept-Language;
+// источник без подходящей локали пропускается.
+func LocaleMiddleware(opts ...MiddlewareOption) func(http.Handler) http.Handler {
+	config := middlewareConfig{
+		sources:    []LocaleSource{SourceQuery, SourceCookie, SourceHeader},
+		queryParam: "locale",
+		cookieName: "locale",
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(config.sources, SourceHeader) {
+				w.Header().Add("Vary", "Accept-Language")
+			}
+			if slices.Contains(config.sources, SourceCookie) {
+				w.Header().Add("Vary", "Cookie")
+			}
+
+			locale := config.negotiate(r)
+			options := append([]FormatterOption{WithLocale(locale)}, config.options...)
+			ctx := NewContext(r.Context(), NewFormatter(options...))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// negotiate перебирает источники по порядку и возвращает первую подходящую
+// локаль или локаль по умолчанию
+func (c middlewareConfig) negotiate(r *http.Request) string {
+	for _, source := range c.sources {
+		var value string
+		switch source {
+		case SourceQuery:
+			value = r.URL.Query().Get(c.queryParam)
+		case SourceCookie:
+			if cookie, err := r.Cookie(c.cookieName); err == nil {
+				value = cookie.Value
+			}
+		case SourceHeader:
+			value = r.Header.Get("Accept-Language")
+		}
+
+		if locale, ok := matchLocale(value, c.supported); ok {
+			return locale
+		}
+	}
+	return MatchLocale("", c.supported)
+}
+
+// formatterKey является ключом форматтера в context.Context
+type formatterKey struct{}
+
+// NewContext возвращает копию контекста с форматтером
+func NewContext(ctx context.Context, f *Formatter) context.Context {
+	return context.WithValue(ctx, formatterKey{}, f)
+}
+
+// FromContext возвращает форматтер, сохраненный в контексте LocaleMiddleware
+// или NewContext
+func FromContext(ctx context.Context) (*Formatter, bool) {
+	f, ok := ctx.Value(formatterKey{}).(*Formatter)
+	return f, ok && f != nil
+}
+
+// FormatContext форматирует число форматтером из контекста, а без него -
+// форматтером по умолчанию
+func FormatContext(ctx context.Context, number float64) string {
+	if f, ok := FromContext(ctx); ok {
+		return f.Format(number)
+	}
+	return NewFormatter().Format(number)
+}
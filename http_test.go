@@ -0,0 +1,133 @@
+//go:build !gonumfmt_minimal
+
+package gonumfmt
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestLocaleMiddleware(t *testing.T) {
+	SetDefaultLocale("en")
+	t.Cleanup(func() { SetDefaultLocaleDetector(nil) })
+
+	tests := []struct {
+		name     string
+		opts     []MiddlewareOption
+		target   string
+		cookie   string
+		header   string
+		expected string
+	}{
+		{"Accept-Language", nil, "/", "", "de-CH, en;q=0.5", "1’234.5"},
+		{"Query before header", nil, "/?locale=ru", "", "de", "1 234,5"},
+		{"Cookie before header", nil, "/", "fr", "de", "1 234,5"},
+		{"Query before cookie", nil, "/?locale=de", "fr", "", "1.234,5"},
+		{"Unsupported query skipped", nil, "/?locale=xx", "", "de", "1.234,5"},
+		{"Default", nil, "/", "", "", "1,234.5"},
+		{
+			"Header first",
+			[]MiddlewareOption{WithLocaleSources(SourceHeader, SourceQuery)},
+			"/?locale=ru", "", "de", "1.234,5",
+		},
+		{
+			"Custom names",
+			[]MiddlewareOption{WithQueryParameter("lang"), WithCookieName("lang")},
+			"/?locale=ru", "de", "", "1.234,5",
+		},
+		{
+			"Supported locales",
+			[]MiddlewareOption{WithSupportedLocales("en", "de")},
+			"/?locale=ru", "", "de-AT;q=0.8, fr", "1.234,5",
+		},
+		{
+			"Supported default",
+			[]MiddlewareOption{WithSupportedLocales("de", "en")},
+			"/", "", "ja", "1.234,5",
+		},
+		{
+			"Formatter options",
+			[]MiddlewareOption{WithFormatterOptions(WithStyle(Percent))},
+			"/", "", "en", "123,450%",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := LocaleMiddleware(tt.opts...)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(FormatContext(r.Context(), 1234.5)))
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: "lang", Value: tt.cookie})
+				req.AddCookie(&http.Cookie{Name: "locale", Value: tt.cookie})
+			}
+			if tt.header != "" {
+				req.Header.Set("Accept-Language", tt.header)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if result := rec.Body.String(); result != tt.expected {
+				t.Errorf("response = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLocaleMiddlewareDefaultLocale(t *testing.T) {
+	SetDefaultLocale("de")
+	t.Cleanup(func() { SetDefaultLocaleDetector(nil) })
+
+	handler := LocaleMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(FormatContext(r.Context(), 1234.5)))
+	}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if result := rec.Body.String(); result != "1.234,5" {
+		t.Errorf("response without Accept-Language = %q, expected default locale %q", result, "1.234,5")
+	}
+}
+
+func TestLocaleMiddlewareVary(t *testing.T) {
+	handler := LocaleMiddleware()(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if vary := rec.Header().Values("Vary"); !slices.Equal(vary, []string{"Accept-Language", "Cookie"}) {
+		t.Errorf("Vary = %q, expected Accept-Language and Cookie", vary)
+	}
+
+	handler = LocaleMiddleware(WithLocaleSources(SourceQuery))(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if vary := rec.Header().Values("Vary"); len(vary) != 0 {
+		t.Errorf("Vary = %q for query source, expected none", vary)
+	}
+}
+
+func TestLocaleMiddlewareCanonicalLocale(t *testing.T) {
+	config := middlewareConfig{sources: []LocaleSource{SourceQuery}, queryParam: "locale"}
+	for _, target := range []string{"/?locale=en-x-1", "/?locale=en-x-2", "/?locale=EN_us", "/?locale=en-US-u-ca-gregory"} {
+		if locale := config.negotiate(httptest.NewRequest(http.MethodGet, target, nil)); locale != "en" {
+			t.Errorf("negotiate(%s) = %q, expected en", target, locale)
+		}
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	if _, ok := FromContext(context.Background()); ok {
+		t.Error("FromContext found a formatter in an empty context")
+	}
+	if result := FormatContext(context.Background(), 1234.5); result != "1,234.5" {
+		t.Errorf("FormatContext without formatter = %q, expected %q", result, "1,234.5")
+	}
+
+	ctx := NewContext(context.Background(), NewFormatter(WithLocale("de")))
+	if f, ok := FromContext(ctx); !ok || f.Format(1234.5) != "1.234,5" {
+		t.Errorf("FromContext did not return the stored formatter")
+	}
+}
@@ -0,0 +1,295 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// numbersFile соответствует cldr-numbers-full/main/<locale>/numbers.json
+type numbersFile struct {
+	Main map[string]struct {
+		Numbers numbersData `json:"numbers"`
+	} `json:"main"`
+}
+
+// numbersData содержит нужные генератору поля числовых данных локали.
+// Символы и шаблоны берутся для латинской системы: цифры и разделители
+// других систем подставляет форматтер (numbering.go).
+type numbersData struct {
+	DefaultNumberingSystem string         `json:"defaultNumberingSystem"`
+	MinimumGroupingDigits  string         `json:"minimumGroupingDigits"`
+	Symbols                symbols        `json:"symbols-numberSystem-latn"`
+	DecimalFormats         decimalFormats `json:"decimalFormats-numberSystem-latn"`
+	PercentFormats         struct {
+		Standard string `json:"standard"`
+	} `json:"percentFormats-numberSystem-latn"`
+	CurrencyFormats struct {
+		Standard   string `json:"standard"`
+		Accounting string `json:"accounting"`
+	} `json:"currencyFormats-numberSystem-latn"`
+}
+
+// symbols содержит символы латинской системы счисления
+type symbols struct {
+	Decimal     string `json:"decimal"`
+	Group       string `json:"group"`
+	PercentSign string `json:"percentSign"`
+	PlusSign    string `json:"plusSign"`
+	MinusSign   string `json:"minusSign"`
+	Exponential string `json:"exponential"`
+	Infinity    string `json:"infinity"`
+	NaN         string `json:"nan"`
+}
+
+// decimalFormats содержит стандартный и компактные шаблоны чисел
+type decimalFormats struct {
+	Standard string `json:"standard"`
+	Long     struct {
+		DecimalFormat map[string]string `json:"decimalFormat"`
+	} `json:"long"`
+	Short struct {
+		DecimalFormat map[string]string `json:"decimalFormat"`
+	} `json:"short"`
+}
+
+// currenciesFile соответствует cldr-numbers-full/main/<locale>/currencies.json
+type currenciesFile struct {
+	Main map[string]struct {
+		Numbers struct {
+			Currencies map[string]struct {
+				DisplayName string `json:"displayName"`
+				Symbol      string `json:"symbol"`
+			} `json:"currencies"`
+		} `json:"numbers"`
+	} `json:"main"`
+}
+
+// currencySupplemental соответствует cldr-core/supplemental/currencyData.json
+type currencySupplemental struct {
+	Supplemental struct {
+		CurrencyData struct {
+			Fractions map[string]fraction                       `json:"fractions"`
+			Region    map[string][]map[string]map[string]string `json:"region"`
+		} `json:"currencyData"`
+	} `json:"supplemental"`
+}
+
+// fraction описывает minor units валюты
+type fraction struct {
+	Digits       string `json:"_digits"`
+	Rounding     string `json:"_rounding"`
+	CashDigits   string `json:"_cashDigits"`
+	CashRounding string `json:"_cashRounding"`
+}
+
+// likelySubtagsFile соответствует cldr-core/supplemental/likelySubtags.json
+type likelySubtagsFile struct {
+	Supplemental struct {
+		LikelySubtags map[string]string `json:"likelySubtags"`
+	} `json:"supplemental"`
+}
+
+// parentLocalesFile соответствует cldr-core/supplemental/parentLocales.json
+type parentLocalesFile struct {
+	Supplemental struct {
+		ParentLocales struct {
+			ParentLocale map[string]string `json:"parentLocale"`
+		} `json:"parentLocales"`
+	} `json:"supplemental"`
+}
+
+// aliasesFile соответствует cldr-core/supplemental/aliases.json
+type aliasesFile struct {
+	Supplemental struct {
+		Metadata struct {
+			Alias struct {
+				LanguageAlias  map[string]alias `json:"languageAlias"`
+				TerritoryAlias map[string]alias `json:"territoryAlias"`
+			} `json:"alias"`
+		} `json:"metadata"`
+	} `json:"supplemental"`
+}
+
+// alias описывает замену устаревшего или избыточного кода
+type alias struct {
+	Replacement string `json:"_replacement"`
+	Reason      string `json:"_reason"`
+}
+
+// cldr загружает файлы снимка CLDR из каталога
+type cldr struct {
+	dir string
+}
+
+// readJSON читает JSON-файл по пути относительно каталога снимка
+func (c cldr) readJSON(v any, elem ...string) error {
+	path := filepath.Join(append([]string{c.dir}, elem...)...)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// numbers возвращает числовые данные локали
+func (c cldr) numbers(locale string) (numbersData, error) {
+	var file numbersFile
+	if err := c.readJSON(&file, "cldr-numbers-full", "main", locale, "numbers.json"); err != nil {
+		return numbersData{}, err
+	}
+	main, ok := file.Main[locale]
+	if !ok {
+		return numbersData{}, fmt.Errorf("numbers.json: no data for %s", locale)
+	}
+	return main.Numbers, nil
+}
+
+// numberingSymbols возвращает символы корневой локали для числовых систем,
+// кроме latn. Символы большинства систем в CLDR ссылаются на latn, поэтому
+// остаются только системы с собственными символами, например arab и arabext.
+func (c cldr) numberingSymbols() (map[string]symbols, error) {
+	var file struct {
+		Main map[string]struct {
+			Numbers map[string]json.RawMessage `json:"numbers"`
+		} `json:"main"`
+	}
+	if err := c.readJSON(&file, "cldr-numbers-full", "main", "root", "numbers.json"); err != nil {
+		return nil, err
+	}
+
+	blocks := make(map[string]symbols)
+	for key, raw := range file.Main["root"].Numbers {
+		system, ok := strings.CutPrefix(key, "symbols-numberSystem-")
+		if !ok {
+			continue
+		}
+		var s symbols
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, fmt.Errorf("root %s: %w", key, err)
+		}
+		blocks[system] = s
+	}
+
+	latn, ok := blocks["latn"]
+	if !ok {
+		return nil, fmt.Errorf("numbers.json: no latn symbols for root")
+	}
+	delete(blocks, "latn")
+	for system, s := range blocks {
+		if s == latn {
+			delete(blocks, system)
+		}
+	}
+	return blocks, nil
+}
+
+// currencies возвращает символы и названия валют локали
+func (c cldr) currencies(locale string) (map[string][2]string, error) {
+	var file currenciesFile
+	if err := c.readJSON(&file, "cldr-numbers-full", "main", locale, "currencies.json"); err != nil {
+		return nil, err
+	}
+	main, ok := file.Main[locale]
+	if !ok {
+		return nil, fmt.Errorf("currencies.json: no data for %s", locale)
+	}
+
+	result := make(map[string][2]string, len(main.Numbers.Currencies))
+	for code, currency := range main.Numbers.Currencies {
+		result[code] = [2]string{currency.Symbol, currency.DisplayName}
+	}
+	return result, nil
+}
+
+// supplementalData содержит нужные генератору общие данные CLDR
+type supplementalData struct {
+	currencySupplemental
+	// likely сопоставляет язык наиболее вероятному полному тегу
+	likely map[string]string
+	// parents содержит родителей, отличных от усечения тега ("en-IN" -> "en-001")
+	parents map[string]string
+	// languageAliases и territoryAliases заменяют устаревшие коды ("iw" -> "he")
+	languageAliases  map[string]alias
+	territoryAliases map[string]alias
+}
+
+// supplemental загружает minor units, валюты регионов, likely subtags,
+// родительские локали и псевдонимы кодов
+func (c cldr) supplemental() (supplementalData, error) {
+	var data supplementalData
+	if err := c.readJSON(&data.currencySupplemental, "cldr-core", "supplemental", "currencyData.json"); err != nil {
+		return data, err
+	}
+
+	var likely likelySubtagsFile
+	if err := c.readJSON(&likely, "cldr-core", "supplemental", "likelySubtags.json"); err != nil {
+		return data, err
+	}
+	data.likely = likely.Supplemental.LikelySubtags
+
+	var parents parentLocalesFile
+	if err := c.readJSON(&parents, "cldr-core", "supplemental", "parentLocales.json"); err != nil {
+		return data, err
+	}
+	data.parents = parents.Supplemental.ParentLocales.ParentLocale
+
+	var aliases aliasesFile
+	if err := c.readJSON(&aliases, "cldr-core", "supplemental", "aliases.json"); err != nil {
+		return data, err
+	}
+	data.languageAliases = aliases.Supplemental.Metadata.Alias.LanguageAlias
+	data.territoryAliases = aliases.Supplemental.Metadata.Alias.TerritoryAlias
+	return data, nil
+}
+
+// parent возвращает родительскую локаль CLDR: явно заданную в parentLocales
+// или тег без последнего подтега
+func (s supplementalData) parent(tag string) string {
+	if parent, ok := s.parents[tag]; ok {
+		return parent
+	}
+	if i := strings.LastIndex(tag, "-"); i > 0 {
+		return tag[:i]
+	}
+	return ""
+}
+
+// fractions возвращает minor units и шаги округления валюты с учетом
+// значения DEFAULT. Для наличных по умолчанию действуют обычные значения.
+func (s currencySupplemental) fractions(code string) (digits, rounding, cashDigits, cashRounding int) {
+	fractions := s.Supplemental.CurrencyData.Fractions
+	value, ok := fractions[code]
+	if !ok {
+		value = fractions["DEFAULT"]
+	}
+	number := func(text string, fallback int) int {
+		if n, err := strconv.Atoi(text); err == nil {
+			return n
+		}
+		return fallback
+	}
+	digits = number(value.Digits, 2)
+	rounding = number(value.Rounding, 0)
+	return digits, rounding, number(value.CashDigits, digits), number(value.CashRounding, rounding)
+}
+
+// regionCurrency возвращает действующую валюту региона: запись без "_to",
+// которая является законным платежным средством
+func (s currencySupplemental) regionCurrency(region string) string {
+	for _, entry := range s.Supplemental.CurrencyData.Region[region] {
+		for code, info := range entry {
+			if _, ended := info["_to"]; ended || info["_tender"] == "false" {
+				continue
+			}
+			return code
+		}
+	}
+	return ""
+}
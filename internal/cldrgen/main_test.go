@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPatternTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		symbol   rune
+		expected string
+	}{
+		{"Currency prefix", "¤#,##0.00", '¤', "{symbol}{number}"},
+		{"Currency suffix", "#,##0.00 ¤", '¤', "{number} {symbol}"},
+		{"Negative subpattern ignored", "¤ #,##0.00;¤-#,##0.00", '¤', "{symbol} {number}"},
+		{"Percent prefix", "%#,##0", '%', "{symbol}{number}"},
+		{"Indian grouping", "#,##,##0%", '%', "{number}{symbol}"},
+		{"Quoted literal", "#,##0.00 'Kč'", '¤', "{number} Kč"},
+		{"Escaped quote", "#,##0''", '%', "{number}'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := patternTemplate(tt.pattern, tt.symbol); result != tt.expected {
+				t.Errorf("patternTemplate(%q) = %q, expected %q", tt.pattern, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNegativeTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		expected string
+	}{
+		{"Implicit negative", "¤#,##0.00", "{sign}{symbol}{number}"},
+		{"Sign after symbol", "¤ #,##0.00;¤-#,##0.00", "{symbol}{sign}{number}"},
+		{"Sign before number", "¤ #,##0.00;¤ -#,##0.00", "{symbol} {sign}{number}"},
+		{"Accounting parentheses", "¤#,##0.00;(¤#,##0.00)", "({symbol}{number})"},
+		{"Suffix in parentheses", "#,##0.00 ¤;(#,##0.00 ¤)", "({number} {symbol})"},
+		{"Quoted minus", "#,##0.00 '-'¤;#,##0.00- '-'¤", "{number}{sign} -{symbol}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := negativeTemplate(tt.pattern, '¤'); result != tt.expected {
+				t.Errorf("negativeTemplate(%q) = %q, expected %q", tt.pattern, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGroupingSizes(t *testing.T) {
+	tests := []struct {
+		pattern   string
+		primary   int
+		secondary int
+	}{
+		{"#,##0.###", 3, 3},
+		{"#,##,##0.###", 3, 2},
+		{"¤#,##,##0.00", 3, 2},
+		{"#,###0.###", 4, 4},
+		{"0.###", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			primary, secondary := groupingSizes(tt.pattern)
+			if primary != tt.primary || secondary != tt.secondary {
+				t.Errorf("groupingSizes(%q) = %d, %d, expected %d, %d",
+					tt.pattern, primary, secondary, tt.primary, tt.secondary)
+			}
+		})
+	}
+}
+
+func TestCompactPatterns(t *testing.T) {
+	short := map[string]string{
+		"1000-count-one":          "0K",
+		"1000-count-other":        "0",
+		"10000-count-other":       "0",
+		"1000000-count-other":     "0 Mio'.'",
+		"10000000-count-other":    "00 Mio'.'",
+		"1000000000-count-other":  "0000 Mio'.'",
+		"10000000000-count-other": "00 Mrd'.'",
+	}
+	long := map[string]string{
+		"1000-count-other":    "0 Tausend",
+		"10000-count-other":   "00 Tausend",
+		"1000000-count-other": "0 Millionen",
+	}
+
+	expected := []compactPattern{
+		{Exponent: 3, Range: "Thousand", Long: "0 Tausend"},
+		{Exponent: 6, Range: "Million", Short: "0 Mio.", Long: "0 Millionen"},
+		{Exponent: 9, Range: "Billion", Short: "0 Mrd."},
+	}
+
+	result := compactPatterns(short, long)
+	if len(result) != len(expected) {
+		t.Fatalf("compactPatterns() = %+v, expected %+v", result, expected)
+	}
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Errorf("compactPatterns()[%d] = %+v, expected %+v", i, result[i], expected[i])
+		}
+	}
+}
+
+func TestOverlayEntry(t *testing.T) {
+	parent := localeEntry{
+		Key:                   "de",
+		DecimalSeparator:      ",",
+		GroupSeparator:        ".",
+		PrimaryGroupSize:      3,
+		SecondaryGroupSize:    3,
+		MinimumGroupingDigits: 1,
+		CurrencyPattern:       "{number} {symbol}",
+		DefaultCurrency:       "EUR",
+		Currencies: []currencyEntry{
+			{Code: "EUR", Symbol: "€", Name: "Euro"},
+			{Code: "USD", Symbol: "$", Name: "US-Dollar"},
+		},
+		CompactPatterns: []compactPattern{
+			{Exponent: 6, Range: "Million", Short: "0 Mio.", Long: "0 Millionen"},
+			{Exponent: 9, Range: "Billion", Short: "0 Mrd.", Long: "0 Milliarden"},
+		},
+	}
+	child := parent
+	child.Key = "de-ch"
+	child.DecimalSeparator, child.GroupSeparator = ".", "’"
+	child.MinimumGroupingDigits = 2
+	child.DefaultCurrency = "CHF"
+	child.Currencies = []currencyEntry{
+		{Code: "EUR", Symbol: "EUR", Name: "Euro"},
+		{Code: "USD", Symbol: "$", Name: "US-Dollar"},
+	}
+	child.CompactPatterns = []compactPattern{
+		{Exponent: 6, Range: "Million", Short: "0 Mio.", Long: "0 Millionen"},
+	}
+
+	overlay := overlayEntry(parent, child)
+
+	// Шаблоны суммы записываются парой, даже если изменился только один
+	signed := child
+	signed.CurrencyNegative = "{symbol}{sign}{number}"
+	if o := overlayEntry(parent, signed); o.CurrencyPattern != child.CurrencyPattern || o.CurrencyNegative != signed.CurrencyNegative {
+		t.Errorf("overlay currency patterns = %q %q, expected both", o.CurrencyPattern, o.CurrencyNegative)
+	}
+
+	if overlay.DecimalSeparator != "." || overlay.GroupSeparator != "’" || overlay.DefaultCurrency != "CHF" {
+		t.Errorf("overlay symbols = %q %q %q", overlay.DecimalSeparator, overlay.GroupSeparator, overlay.DefaultCurrency)
+	}
+	if overlay.CurrencyPattern != "" || overlay.PrimaryGroupSize != 0 {
+		t.Errorf("overlay repeats inherited fields: %q, %d", overlay.CurrencyPattern, overlay.PrimaryGroupSize)
+	}
+	if overlay.CurrencyNegative != "" || overlay.AccountingPattern != "" {
+		t.Errorf("overlay repeats inherited currency patterns: %q, %q", overlay.CurrencyNegative, overlay.AccountingPattern)
+	}
+	if overlay.MinimumGroupingDigits != 2 {
+		t.Errorf("overlay MinimumGroupingDigits = %d, expected 2", overlay.MinimumGroupingDigits)
+	}
+	if len(overlay.Currencies) != 1 || overlay.Currencies[0].Code != "EUR" {
+		t.Errorf("overlay currencies = %+v, expected only EUR", overlay.Currencies)
+	}
+	// Диапазон родителя, которого нет у варианта, отключается пустыми шаблонами
+	expected := []compactPattern{{Exponent: 9, Range: "Billion"}}
+	if len(overlay.CompactPatterns) != 1 || overlay.CompactPatterns[0] != expected[0] {
+		t.Errorf("overlay compact patterns = %+v, expected %+v", overlay.CompactPatterns, expected)
+	}
+}
+
+func TestRegion(t *testing.T) {
+	likely := map[string]string{"de": "de-Latn-DE", "zh": "zh-Hans-CN", "zh-Hant": "zh-Hant-TW"}
+
+	tests := map[string]string{
+		"de":         "DE",
+		"de-CH":      "CH",
+		"zh":         "CN",
+		"zh-Hant":    "TW",
+		"zh-Hant-HK": "HK",
+		"xx":         "",
+	}
+	for tag, expected := range tests {
+		if result := region(tag, likely); result != expected {
+			t.Errorf("region(%q) = %q, expected %q", tag, result, expected)
+		}
+	}
+}
+
+// TestGeneratedFilesUpToDate проверяет, что сгенерированные файлы пакета
+// соответствуют снимку CLDR и config.json
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	files, err := generate(filepath.Join("..", "..", "testdata", "cldr"), "config.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, source := range files {
+		current, err := os.ReadFile(filepath.Join("..", "..", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(current, source) {
+			t.Errorf("%s is out of date, run go generate", name)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// compactRanges сопоставляет десятичный порядок диапазону компактной записи gonumfmt
+var compactRanges = map[int]string{
+	3:  "Thousand",
+	4:  "TenThousand",
+	5:  "Lakh",
+	6:  "Million",
+	7:  "Crore",
+	8:  "HundredMillion",
+	9:  "Billion",
+	11: "Kharab",
+	12: "Trillion",
+}
+
+// positiveSubpattern возвращает часть шаблона CLDR до ";" вне кавычек
+func positiveSubpattern(pattern string) string {
+	positive, _, _ := cutSubpatterns(pattern)
+	return positive
+}
+
+// cutSubpatterns делит шаблон CLDR по ";" вне кавычек на положительную
+// и отрицательную части
+func cutSubpatterns(pattern string) (positive, negative string, found bool) {
+	quoted := false
+	for i, r := range pattern {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case r == ';' && !quoted:
+			return pattern[:i], pattern[i+1:], true
+		}
+	}
+	return pattern, "", false
+}
+
+// isNumberChar сообщает, входит ли символ в числовую часть шаблона CLDR
+func isNumberChar(r rune) bool {
+	return strings.ContainsRune("#0123456789,.@", r)
+}
+
+// patternTemplate переводит шаблон CLDR ("¤#,##0.00", "#,##0 %") в шаблон gonumfmt
+// ("{symbol}{number}", "{number} {symbol}"); symbol - специальный символ шаблона
+func patternTemplate(pattern string, symbol rune) string {
+	return subpatternTemplate(positiveSubpattern(pattern), symbol)
+}
+
+// negativeTemplate переводит отрицательную часть шаблона CLDR в шаблон gonumfmt:
+// "¤#,##0.00;(¤#,##0.00)" дает "({symbol}{number})", "¤ #,##0.00;¤-#,##0.00" -
+// "{symbol}{sign}{number}". Без отрицательной части знак ставится перед
+// положительным шаблоном, как предписывает CLDR.
+func negativeTemplate(pattern string, symbol rune) string {
+	positive, negative, found := cutSubpatterns(pattern)
+	if !found {
+		return "{sign}" + subpatternTemplate(positive, symbol)
+	}
+	return subpatternTemplate(negative, symbol)
+}
+
+// subpatternTemplate переводит одну часть шаблона CLDR; минус вне кавычек
+// становится {sign}
+func subpatternTemplate(subpattern string, symbol rune) string {
+	var result strings.Builder
+	quoted, numberWritten := false, false
+
+	runes := []rune(subpattern)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\'' && i+1 < len(runes) && runes[i+1] == '\'':
+			result.WriteRune('\'')
+			i++
+		case r == '\'':
+			quoted = !quoted
+		case quoted:
+			result.WriteRune(r)
+		case isNumberChar(r):
+			if !numberWritten {
+				result.WriteString("{number}")
+				numberWritten = true
+			}
+		case r == symbol:
+			result.WriteString("{symbol}")
+		case r == '-':
+			result.WriteString("{sign}")
+		default:
+			result.WriteRune(r)
+		}
+	}
+	return result.String()
+}
+
+// groupingSizes возвращает основной и вторичный размеры групп из шаблона CLDR:
+// "#,##0.###" дает 3 и 3, "#,##,##0.###" - 3 и 2
+func groupingSizes(pattern string) (primary, secondary int) {
+	pattern = positiveSubpattern(pattern)
+	start := strings.IndexFunc(pattern, isNumberChar)
+	if start < 0 {
+		return 0, 0
+	}
+	end := start
+	for end < len(pattern) && isNumberChar(rune(pattern[end])) {
+		end++
+	}
+	integer, _, _ := strings.Cut(pattern[start:end], ".")
+
+	groups := strings.Split(integer, ",")
+	if len(groups) < 2 {
+		return 0, 0
+	}
+	primary = len(groups[len(groups)-1])
+	secondary = primary
+	if len(groups) > 2 {
+		secondary = len(groups[len(groups)-2])
+	}
+	return primary, secondary
+}
+
+// compactTemplate снимает кавычки с компактного шаблона CLDR и сворачивает
+// нули в один "0". Возвращает шаблон и исходное количество нулей.
+func compactTemplate(pattern string) (string, int) {
+	var result strings.Builder
+	quoted, zeros := false, 0
+
+	runes := []rune(positiveSubpattern(pattern))
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\'' && i+1 < len(runes) && runes[i+1] == '\'':
+			result.WriteRune('\'')
+			i++
+		case r == '\'':
+			quoted = !quoted
+		case quoted:
+			result.WriteRune(r)
+		case r == '0':
+			if zeros == 0 {
+				result.WriteRune('0')
+			}
+			zeros++
+		default:
+			result.WriteRune(r)
+		}
+	}
+	return result.String(), zeros
+}
+
+// compactPattern содержит короткий и длинный шаблоны одного диапазона
+type compactPattern struct {
+	Exponent int
+	Range    string
+	Short    string
+	Long     string
+}
+
+// compactPatterns сводит шаблоны CLDR по степеням десяти ("1000-count-other",
+// "10000-count-other", ...) к диапазонам gonumfmt. Порядок диапазона равен
+// степени минус лишние нули шаблона: "00K" для 10000 относится к тысячам.
+// Для каждого диапазона берется первый шаблон в порядке возрастания степени;
+// шаблон "0" означает, что число не сокращается.
+func compactPatterns(short, long map[string]string) []compactPattern {
+	patterns := make(map[int]*compactPattern)
+
+	collect := func(formats map[string]string, set func(*compactPattern, string)) {
+		type powerPattern struct {
+			power   int
+			pattern string
+		}
+		var powers []powerPattern
+		for key, pattern := range formats {
+			number, ok := strings.CutSuffix(key, "-count-other")
+			if !ok {
+				continue
+			}
+			if _, err := strconv.Atoi(number); err != nil {
+				continue
+			}
+			powers = append(powers, powerPattern{len(number) - 1, pattern})
+		}
+		sort.Slice(powers, func(i, j int) bool { return powers[i].power < powers[j].power })
+
+		seen := make(map[int]bool)
+		for _, p := range powers {
+			tmpl, zeros := compactTemplate(p.pattern)
+			if zeros == 0 || tmpl == "0" {
+				continue
+			}
+			exponent := p.power - (zeros - 1)
+			rangeName, known := compactRanges[exponent]
+			if !known || seen[exponent] {
+				continue
+			}
+			seen[exponent] = true
+
+			if patterns[exponent] == nil {
+				patterns[exponent] = &compactPattern{Exponent: exponent, Range: rangeName}
+			}
+			set(patterns[exponent], tmpl)
+		}
+	}
+	collect(short, func(p *compactPattern, tmpl string) { p.Short = tmpl })
+	collect(long, func(p *compactPattern, tmpl string) { p.Long = tmpl })
+
+	result := make([]compactPattern, 0, len(patterns))
+	for _, p := range patterns {
+		result = append(result, *p)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Exponent < result[j].Exponent })
+	return result
+}
+
+// asciiSpaces заменяет неразрывные пробелы CLDR обычными пробелами
+var asciiSpaces = strings.NewReplacer("\u00a0", " ", "\u202f", " ")
@@ -0,0 +1,84 @@
+// Пакет localedata связывает пакеты данных CLDR (gonumfmt/locales/...) с
+// gonumfmt. Пакеты данных не импортируют gonumfmt, поэтому gonumfmt может
+// подключить их все по умолчанию, а без этого они подключаются импортом.
+package localedata
+
+import "sync"
+
+// Locale содержит данные локали CLDR; у региональных вариантов заполнены
+// только поля, отличающиеся от родительской локали
+type Locale struct {
+	DecimalSeparator          string
+	GroupSeparator            string
+	PrimaryGroupSize          int
+	SecondaryGroupSize        int
+	MinimumGroupingDigits     int
+	PercentSymbol             string
+	CurrencyPattern           string
+	CurrencyNegativePattern   string
+	AccountingPattern         string
+	AccountingNegativePattern string
+	NegativePattern           string
+	PositivePattern           string
+	PercentPattern            string
+	MinusSign                 string
+	PlusSign                  string
+	Exponential               string
+	Infinity                  string
+	NaN                       string
+	NumberingSystem           string
+	DefaultCurrency           string
+	Currencies                map[string]Currency
+	CompactPatterns           map[int]CompactPattern // ключом служит десятичный порядок
+}
+
+// Currency содержит символ и название валюты в локали
+type Currency struct {
+	Symbol string
+	Name   string
+}
+
+// CompactPattern содержит шаблоны компактной записи одного диапазона
+type CompactPattern struct {
+	Short string
+	Long  string
+}
+
+// Pack содержит данные одного языка: полные данные и региональные варианты
+// по ключам локалей gonumfmt ("de", "de-ch")
+type Pack struct {
+	Language string
+	Locales  map[string]*Locale
+	Overlays map[string]*Locale
+}
+
+var (
+	mutex  sync.Mutex
+	packs  []*Pack
+	linker func([]*Pack)
+)
+
+// Register добавляет пакет данных; вызывается из init пакетов locales/...
+func Register(pack *Pack) {
+	mutex.Lock()
+	packs = append(packs, pack)
+	link := linker
+	mutex.Unlock()
+
+	if link != nil {
+		link([]*Pack{pack})
+	}
+}
+
+// SetLinker задает функцию, получающую пакеты данных: сразу все уже
+// зарегистрированные, затем каждый новый
+func SetLinker(link func([]*Pack)) {
+	mutex.Lock()
+	linker = link
+	registered := packs
+	mutex.Unlock()
+
+	if len(registered) > 0 {
+		link(registered)
+	}
+}
@@ -0,0 +1,42 @@
+package gonumfmt
+
+// invariantFractionDigits хватает для кратчайшей записи любого float64:
+// у чисел около 1e-308 она содержит до 17 значащих цифр
+const invariantFractionDigits = 340
+
+// NewInvariantFormatter создает форматтер машиночитаемого вида для логов, CSV
+// и API: корневая локаль без группировки, "." как десятичный разделитель,
+// ASCII-минус, "NaN", "Inf" и "-Inf" и кратчайшая запись числа, которую
+// strconv.ParseFloat читает обратно без потерь (отрицательный ноль выводится
+// как "0"). Вывод не зависит от локали
+// по умолчанию и данных CLDR. Опции применяются поверх пресета, но локаль
+// и числовая система не меняются.
+func NewInvariantFormatter(opts ...FormatterOption) *Formatter {
+	options := append([]FormatterOption{
+		WithGroupingStrategy(GroupingOff),
+		WithPrecision(0, invariantFractionDigits),
+	}, opts...)
+	options = append(options, WithLocale(rootLocale), WithNumberingSystem("latn"))
+
+	f := NewFormatter(options...)
+	locale := f.locale.clone()
+	locale.DecimalSeparator = "."
+	locale.GroupSeparator = ","
+	locale.PercentSymbol = "%"
+	locale.PercentPattern = "{number}{symbol}"
+	locale.NegativePattern = "{sign}{number}"
+	locale.PositivePattern = "{sign}{number}"
+	locale.MinusSign = "-"
+	locale.PlusSign = "+"
+	locale.Exponential = "E"
+	locale.SuperscriptingExponent = false
+	locale.Infinity = "Inf"
+	locale.NaN = "NaN"
+	f.locale = locale
+	return f
+}
+
+// FormatInvariant форматирует число в машиночитаемом виде (см. NewInvariantFormatter)
+func FormatInvariant(number float64) string {
+	return NewInvariantFormatter().Format(number)
+}
@@ -0,0 +1,99 @@
+package gonumfmt
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestInvariantFormatter(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   float64
+		opts     []FormatterOption
+		expected string
+	}{
+		{"No grouping", 1234567.891, nil, "1234567.891"},
+		{"All fraction digits", 0.1234567890123, nil, "0.1234567890123"},
+		{"Negative", -42.5, nil, "-42.5"},
+		{"Integer", 1e6, nil, "1000000"},
+		{"Small", 1e-7, nil, "0.0000001"},
+		{"NaN", math.NaN(), nil, "NaN"},
+		{"Infinity", math.Inf(1), nil, "Inf"},
+		{"Negative infinity", math.Inf(-1), nil, "-Inf"},
+		{"Locale ignored", 1234.5, []FormatterOption{WithLocale("de")}, "1234.5"},
+		{"Numbering system ignored", 1234.5, []FormatterOption{WithNumberingSystem("arab")}, "1234.5"},
+		{"Precision option", 2.0 / 3, []FormatterOption{WithPrecision(2, 2)}, "0.67"},
+		{"Percent style", 0.255, []FormatterOption{WithStyle(Percent)}, "25.5%"},
+		{"Scientific style", 1234.5, []FormatterOption{WithStyle(Scientific)}, "1.2345E3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := NewInvariantFormatter(tt.opts...).Format(tt.number); result != tt.expected {
+				t.Errorf("Format(%v) = %q, expected %q", tt.number, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestInvariantDefaultLocaleIndependent(t *testing.T) {
+	t.Cleanup(func() { SetDefaultLocaleDetector(nil) })
+	SetDefaultLocale("fr")
+	if result := FormatInvariant(-1234.5); result != "-1234.5" {
+		t.Errorf("FormatInvariant(-1234.5) with default locale fr = %q, expected %q", result, "-1234.5")
+	}
+}
+
+func TestInvariantRoundTrip(t *testing.T) {
+	f := NewInvariantFormatter()
+	values := []float64{
+		0, math.Copysign(0, -1), 1, -1, 0.1, 1.0 / 3, math.Pi, 123456789.123456789,
+		math.MaxFloat64, -math.MaxFloat64, math.SmallestNonzeroFloat64, 2.2250738585072014e-308,
+		math.MaxInt64, 1e21, 1e-21, math.Inf(1), math.Inf(-1),
+	}
+	random := rand.New(rand.NewSource(1))
+	for range 1000 {
+		values = append(values, math.Float64frombits(random.Uint64()))
+	}
+
+	for _, value := range values {
+		text := f.Format(value)
+		parsed, err := strconv.ParseFloat(text, 64)
+		if math.IsNaN(value) {
+			if err != nil || !math.IsNaN(parsed) {
+				t.Errorf("ParseFloat(%q) = %v, %v, expected NaN", text, parsed, err)
+			}
+			continue
+		}
+		// Отрицательный ноль выводится как "0", поэтому значения сравниваются, а не биты
+		if err != nil || parsed != value {
+			t.Errorf("ParseFloat(Format(%v)) = %v, %v (text %q)", value, parsed, err, text)
+		}
+	}
+}
+
+func TestRootLocale(t *testing.T) {
+	tests := []struct {
+		name     string
+		locale   string
+		opts     []FormatterOption
+		expected string
+	}{
+		{"Decimal", "root", nil, "1,234,567.5"},
+		{"Undetermined", "und", nil, "1,234,567.5"},
+		{"Compact", "root", []FormatterOption{WithStyle(Compact)}, "1.235M"},
+		{"Currency", "und", []FormatterOption{WithStyle(Currency), WithCurrency("USD")}, "US$ 1,234,567.50"},
+		{"POSIX locale", normalizeSystemLocale("C.UTF-8"), nil, "1,234,567.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFormatter(append([]FormatterOption{WithLocale(tt.locale)}, tt.opts...)...)
+			if result := f.Format(1234567.5); result != tt.expected {
+				t.Errorf("Format(1234567.5) in %s = %q, expected %q", tt.locale, result, tt.expected)
+			}
+		})
+	}
+}
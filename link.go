@@ -0,0 +1,111 @@
+package gonumfmt
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/madebydima/gonumfmt/internal/localedata"
+
+	// Корневая локаль и запасная локаль "en" подключены всегда
+	_ "github.com/madebydima/gonumfmt/locales/en"
+	_ "github.com/madebydima/gonumfmt/locales/root"
+)
+
+// ErrLocaleNotLinked возвращается для локали CLDR, пакет данных которой не
+// подключен к программе (сборка с тегом gonumfmt_minimal)
+var ErrLocaleNotLinked = errors.New("locale not linked")
+
+// ErrUnsupportedLocale возвращается для локали без данных
+var ErrUnsupportedLocale = errors.New("unsupported locale")
+
+// LookupLocaleData возвращает копию данных локали, как GetLocaleData, но
+// вместо данных запасной локали "en" возвращает ошибку: ErrLocaleNotLinked,
+// если локаль есть в CLDR, но ее пакет locales/... не подключен, и
+// ErrUnsupportedLocale, если данных нет вовсе.
+func LookupLocaleData(locale string) (*LocaleData, error) {
+	s := locales.Load()
+	if pack := s.missingPack(locale); pack != "" {
+		return nil, fmt.Errorf("gonumfmt: locale %q: %w: import _ %q or build without the gonumfmt_minimal tag",
+			locale, ErrLocaleNotLinked, "github.com/madebydima/gonumfmt/locales/"+pack)
+	}
+	// Корневая локаль, унаследованная по parentLocales, тоже дает данные
+	if chain, found := s.chain(locale); !found && chain[len(chain)-1] != rootLocale {
+		return nil, fmt.Errorf("gonumfmt: locale %q: %w", locale, ErrUnsupportedLocale)
+	}
+	return s.lookup(locale).clone(), nil
+}
+
+// missingPack возвращает пакет данных, который дал бы локали данные, если
+// его подключить, или пустую строку, если данные уже подключены
+func (s *localeSnapshot) missingPack(locale string) string {
+	for tag := languageTag(locale); tag != ""; tag = parentLocale(tag) {
+		if s.custom[tag] != nil || s.overlays[tag] != nil || s.data[tag] != nil {
+			return ""
+		}
+		if pack, exists := localePacks[tag]; exists {
+			return pack
+		}
+	}
+	return ""
+}
+
+// linkLocalePacks добавляет данные пакетов locales/... в снимок локалей
+func linkLocalePacks(packs []*localedata.Pack) {
+	replaceLocales(func(data, overlays, _ map[string]*LocaleData) {
+		for _, pack := range packs {
+			for key, locale := range pack.Locales {
+				data[key] = packLocaleData(locale)
+			}
+			for key, locale := range pack.Overlays {
+				overlays[key] = packLocaleData(locale)
+			}
+		}
+	})
+}
+
+// packLocaleData преобразует данные пакета locales/... в LocaleData
+func packLocaleData(l *localedata.Locale) *LocaleData {
+	data := &LocaleData{
+		DecimalSeparator:          l.DecimalSeparator,
+		GroupSeparator:            l.GroupSeparator,
+		PrimaryGroupSize:          l.PrimaryGroupSize,
+		SecondaryGroupSize:        l.SecondaryGroupSize,
+		MinimumGroupingDigits:     l.MinimumGroupingDigits,
+		PercentSymbol:             l.PercentSymbol,
+		CurrencyPattern:           l.CurrencyPattern,
+		CurrencyNegativePattern:   l.CurrencyNegativePattern,
+		AccountingPattern:         l.AccountingPattern,
+		AccountingNegativePattern: l.AccountingNegativePattern,
+		NegativePattern:           l.NegativePattern,
+		PositivePattern:           l.PositivePattern,
+		PercentPattern:            l.PercentPattern,
+		MinusSign:                 l.MinusSign,
+		PlusSign:                  l.PlusSign,
+		Exponential:               l.Exponential,
+		Infinity:                  l.Infinity,
+		NaN:                       l.NaN,
+		NumberingSystem:           l.NumberingSystem,
+		DefaultCurrency:           l.DefaultCurrency,
+		CurrencyFormats:           make(map[string]*CurrencyData, len(l.Currencies)),
+		CompactPatterns:           make(map[CompactRange]*CompactPattern, len(l.CompactPatterns)),
+	}
+	for code, currency := range l.Currencies {
+		data.CurrencyFormats[code] = &CurrencyData{Symbol: currency.Symbol, Name: currency.Name}
+	}
+	for exponent, pattern := range l.CompactPatterns {
+		if compactRange, known := compactRangeOf(exponent); known {
+			data.CompactPatterns[compactRange] = &CompactPattern{Short: pattern.Short, Long: pattern.Long}
+		}
+	}
+	return data
+}
+
+// compactRangeOf возвращает диапазон компактной записи по десятичному порядку
+func compactRangeOf(exponent int) (CompactRange, bool) {
+	for compactRange, e := range compactExponents {
+		if e == exponent {
+			return compactRange, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,7 @@
+//go:build !gonumfmt_minimal
+
+package gonumfmt
+
+// Без тега gonumfmt_minimal подключены данные всех локалей. С тегом
+// подключаются только root и en, остальные - импортом пакетов locales/...
+import _ "github.com/madebydima/gonumfmt/locales/all"
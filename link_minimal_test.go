@@ -0,0 +1,33 @@
+//go:build gonumfmt_minimal
+
+package gonumfmt
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMinimalBuild(t *testing.T) {
+	s := locales.Load()
+	for key, pack := range localePacks {
+		linked := s.data[key] != nil || s.overlays[key] != nil
+		if expected := pack == "root" || pack == "en"; linked != expected {
+			t.Errorf("locale %s of pack %s linked = %v, expected %v", key, pack, linked, expected)
+		}
+	}
+
+	for _, locale := range []string{"root", "en", "en-GB", "en-IN"} {
+		if _, err := LookupLocaleData(locale); err != nil {
+			t.Errorf("LookupLocaleData(%q) = %v, expected linked data", locale, err)
+		}
+	}
+	for _, locale := range []string{"de", "de-CH", "zh-TW"} {
+		if _, err := LookupLocaleData(locale); !errors.Is(err, ErrLocaleNotLinked) {
+			t.Errorf("LookupLocaleData(%q) error = %v, expected ErrLocaleNotLinked", locale, err)
+		}
+	}
+
+	if result := NewFormatter(WithLocale("de")).Format(1234.5); result != "1,234.5" {
+		t.Errorf("Format(1234.5) in de without linked data = %q, expected en %q", result, "1,234.5")
+	}
+}
@@ -0,0 +1,93 @@
+//go:build !gonumfmt_minimal
+
+package gonumfmt
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// unlinkLocales убирает из снимка данные языка, как при сборке с тегом
+// gonumfmt_minimal без импорта его пакета
+func unlinkLocales(t *testing.T, language string) {
+	previous := locales.Load()
+	t.Cleanup(func() { locales.Store(previous) })
+
+	replaceLocales(func(data, overlays, _ map[string]*LocaleData) {
+		for key, pack := range localePacks {
+			if pack == language {
+				delete(data, key)
+				delete(overlays, key)
+			}
+		}
+	})
+}
+
+func TestLookupLocaleData(t *testing.T) {
+	tests := []struct {
+		locale    string
+		separator string
+		err       error
+	}{
+		{"de-CH", ".", nil},
+		{"de_AT", ",", nil},
+		{"uz-Arab", ".", nil},
+		{"root", ".", nil},
+		{"xx", "", ErrUnsupportedLocale},
+		{"", "", ErrUnsupportedLocale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			data, err := LookupLocaleData(tt.locale)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("LookupLocaleData(%q) error = %v, expected %v", tt.locale, err, tt.err)
+			}
+			if err == nil && data.DecimalSeparator != tt.separator {
+				t.Errorf("LookupLocaleData(%q).DecimalSeparator = %q, expected %q", tt.locale, data.DecimalSeparator, tt.separator)
+			}
+		})
+	}
+}
+
+func TestLocaleNotLinked(t *testing.T) {
+	unlinkLocales(t, "de")
+	unlinkLocales(t, "zh")
+
+	for locale, pack := range map[string]string{"de": "de", "de-CH": "de", "zh-TW": "zh", "zh-Hant-HK": "zh"} {
+		_, err := LookupLocaleData(locale)
+		if !errors.Is(err, ErrLocaleNotLinked) {
+			t.Errorf("LookupLocaleData(%q) error = %v, expected ErrLocaleNotLinked", locale, err)
+			continue
+		}
+		if !strings.Contains(err.Error(), "gonumfmt/locales/"+pack) {
+			t.Errorf("LookupLocaleData(%q) error %q does not name the locales/%s package", locale, err, pack)
+		}
+	}
+
+	if IsLocaleSupported("de-CH") {
+		t.Error("IsLocaleSupported(de-CH) = true without linked data")
+	}
+	if sep := GetLocaleData("de").DecimalSeparator; sep != "." {
+		t.Errorf("GetLocaleData(de) without linked data DecimalSeparator = %q, expected en %q", sep, ".")
+	}
+	if _, err := LookupLocaleData("fr-CH"); err != nil {
+		t.Errorf("LookupLocaleData(fr-CH) = %v, expected linked data", err)
+	}
+}
+
+func TestLinkedPacks(t *testing.T) {
+	packs := make(map[string]bool)
+	for key, pack := range localePacks {
+		packs[pack] = true
+		if !IsLocaleSupported(key) {
+			t.Errorf("locale %s of pack %s is not linked by default", key, pack)
+		}
+	}
+	for _, pack := range []string{"root", "en", "de", "zh", "sr"} {
+		if !packs[pack] {
+			t.Errorf("localePacks has no pack %s", pack)
+		}
+	}
+}
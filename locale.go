@@ -1,33 +1,51 @@
 package gonumfmt
 
 import (
+	"maps"
 	"strings"
 	"sync"
+	"sync/atomic"
+
+	"github.com/madebydima/gonumfmt/internal/localedata"
 )
 
 // LocaleData содержит данные для форматирования в конкретной локали
 type LocaleData struct {
-	DecimalSeparator       string
-	GroupSeparator         string
-	PercentSymbol          string
-	CurrencyFormats        map[string]*CurrencyData
-	NegativePattern        string
-	PositivePattern        string
-	PercentPattern         string
-	CompactPatterns        map[CompactRange]*CompactPattern
-	MinusSign              string
-	PlusSign               string
-	Exponential            string
-	SuperscriptingExponent bool
-	NumberingSystem        string
+	DecimalSeparator          string
+	GroupSeparator            string
+	PrimaryGroupSize          int // размер группы у десятичной запятой, 0 означает 3
+	SecondaryGroupSize        int // размер остальных групп, 0 означает PrimaryGroupSize
+	MinimumGroupingDigits     int // минимум цифр в старшей группе, 0 означает 1
+	PercentSymbol             string
+	CurrencyFormats           map[string]*CurrencyData
+	CurrencyPattern           string // шаблон для валют без собственной записи в CurrencyFormats
+	CurrencyNegativePattern   string // шаблон отрицательной суммы, пусто означает "{sign}" перед CurrencyPattern
+	AccountingPattern         string // шаблон CurrencySignAccounting, пусто означает шаблоны валюты
+	AccountingNegativePattern string // например "({symbol}{number})", пусто означает "{sign}" перед AccountingPattern или шаблон валюты
+	NegativePattern           string
+	PositivePattern           string
+	PercentPattern            string
+	CompactPatterns           map[CompactRange]*CompactPattern
+	MinusSign                 string
+	PlusSign                  string
+	Exponential               string
+	Infinity                  string // пусто означает "∞"
+	NaN                       string // пусто означает "NaN"
+	SuperscriptingExponent    bool
+	NumberingSystem           string
+	DefaultCurrency           string
 }
 
 // CurrencyData содержит данные о валюте
 type CurrencyData struct {
-	Symbol  string
-	Name    string
-	Format  string
-	Spacing string
+	Symbol       string
+	Name         string
+	Format       string
+	Spacing      string
+	Digits       int // количество знаков после запятой по ISO 4217 (minor units)
+	Rounding     int // шаг округления в единицах последнего знака Digits, 0 - без шага
+	CashDigits   int // знаки после запятой при расчетах наличными (CurrencyUsageCash)
+	CashRounding int // шаг округления наличных: 5 при CashDigits 2 означает 0.05 (CHF)
 }
 
 // CompactRange представляет диапазон для компактной записи
@@ -38,72 +56,287 @@ const (
 	Million
 	Billion
 	Trillion
+	Lakh           // 10^5, индийская система счисления
+	Crore          // 10^7
+	Kharab         // 10^11
+	TenThousand    // 10^4, восточноазиатская система (万, 만)
+	HundredMillion // 10^8 (億, 亿, 억)
 )
 
+// compactExponents задает десятичный порядок каждого диапазона компактной записи
+var compactExponents = map[CompactRange]int{
+	Thousand:       3,
+	TenThousand:    4,
+	Lakh:           5,
+	Million:        6,
+	Crore:          7,
+	HundredMillion: 8,
+	Billion:        9,
+	Kharab:         11,
+	Trillion:       12,
+}
+
 // CompactPattern содержит шаблоны для компактной записи
 type CompactPattern struct {
 	Short string
 	Long  string
 }
 
-// localeCache кэширует загруженные локали
-var localeCache = make(map[string]*LocaleData)
-var cacheMutex sync.RWMutex
+// localeSnapshot является неизменяемым набором данных локалей. Данные всех
+// подключенных и зарегистрированных локалей разрешаются при создании снимка;
+// подключение пакета данных и RegisterLocale заменяют снимок целиком, поэтому
+// читатели не блокируются.
+type localeSnapshot struct {
+	data     map[string]*LocaleData // полные данные CLDR подключенных языков
+	overlays map[string]*LocaleData // региональные варианты: только отличающиеся поля
+	custom   map[string]*LocaleData // локали, зарегистрированные RegisterLocale
+	resolved map[string]*LocaleData // разрешенные данные по ключу локали
+}
+
+// locales хранит текущий снимок данных локалей
+var locales atomic.Pointer[localeSnapshot]
+
+// snapshotMutex упорядочивает замену снимка локалей
+var snapshotMutex sync.Mutex
+
+func init() {
+	locales.Store(newLocaleSnapshot(nil, nil, nil))
+	localedata.SetLinker(linkLocalePacks)
+}
+
+// newLocaleSnapshot разрешает данные всех локалей
+func newLocaleSnapshot(data, overlays, custom map[string]*LocaleData) *localeSnapshot {
+	s := &localeSnapshot{
+		data:     data,
+		overlays: overlays,
+		custom:   custom,
+		resolved: make(map[string]*LocaleData, len(data)+len(overlays)+len(custom)),
+	}
+	for _, keys := range []map[string]*LocaleData{data, overlays, custom} {
+		for key := range keys {
+			chain, _ := s.chain(key)
+			if _, exists := s.resolved[chain[0]]; !exists {
+				s.resolved[chain[0]] = s.build(chain)
+			}
+		}
+	}
+	return s
+}
+
+// replaceLocales заменяет снимок новым, данные которого изменены update
+func replaceLocales(update func(data, overlays, custom map[string]*LocaleData)) {
+	snapshotMutex.Lock()
+	defer snapshotMutex.Unlock()
 
-// GetLocaleData возвращает данные для локали
+	copied := func(m map[string]*LocaleData) map[string]*LocaleData {
+		result := make(map[string]*LocaleData, len(m))
+		maps.Copy(result, m)
+		return result
+	}
+	current := locales.Load()
+	data, overlays, custom := copied(current.data), copied(current.overlays), copied(current.custom)
+	update(data, overlays, custom)
+	locales.Store(newLocaleSnapshot(data, overlays, custom))
+}
+
+// GetLocaleData возвращает копию данных локали; ее изменение не влияет на
+// форматтеры. Для локали без данных возвращаются данные запасной локали "en",
+// причину сообщает LookupLocaleData.
 func GetLocaleData(locale string) *LocaleData {
-	cacheMutex.RLock()
-	if data, exists := localeCache[locale]; exists {
-		cacheMutex.RUnlock()
+	return lookupLocaleData(locale).clone()
+}
+
+// lookupLocaleData возвращает общие данные локали из текущего снимка. Их
+// нельзя изменять.
+func lookupLocaleData(locale string) *LocaleData {
+	return locales.Load().lookup(locale)
+}
+
+// lookup возвращает разрешенные данные локали. Данные берутся по самой
+// точной локали цепочки, которая всегда есть в resolved, поэтому теги из
+// запросов ("de-LI-x-1", "de-12345678") не добавляют в снимок новых записей.
+func (s *localeSnapshot) lookup(locale string) *LocaleData {
+	chain, _ := s.chain(locale)
+	if data, exists := s.resolved[chain[0]]; exists {
 		return data
 	}
-	cacheMutex.RUnlock()
+	return s.build(chain)
+}
 
-	cacheMutex.Lock()
-	defer cacheMutex.Unlock()
+// rootLocale завершает каждую цепочку наследования. Данные корневой локали
+// CLDR уже учтены в полных данных языков; сами по себе они доступны по тегам
+// "root" и "und".
+const rootLocale = "root"
 
-	// Проверяем еще раз на случай параллельной загрузки
-	if data, exists := localeCache[locale]; exists {
-		return data
+// fallbackLocale используется для языков без встроенных данных
+const fallbackLocale = "en"
+
+// LocaleChain возвращает цепочку наследования данных локали от самой точной
+// до корневой: [de-ch de root] для "de-CH", [en-in en-001 en root] для "en-IN".
+// Для языка без встроенных данных цепочка начинается с запасной локали "en".
+func LocaleChain(locale string) []string {
+	chain, _ := localeChain(locale)
+	if chain[len(chain)-1] == rootLocale {
+		return chain
+	}
+	return append(chain, rootLocale)
+}
+
+// localeChain возвращает ключи данных от самой точной локали до полных данных
+// языка и сообщает, найдены ли данные языка или использована запасная локаль.
+// Корневая локаль, унаследованная по parentLocales ("uz-Arab" -> "root"),
+// дает данные, но не считается поддержкой языка.
+func localeChain(locale string) ([]string, bool) {
+	return locales.Load().chain(locale)
+}
+
+// chain возвращает цепочку наследования с учетом зарегистрированных локалей
+// снимка (см. localeChain)
+func (s *localeSnapshot) chain(locale string) ([]string, bool) {
+	key := languageTag(locale)
+
+	var chain []string
+	for tag := key; tag != ""; tag = parentLocale(tag) {
+		if _, exists := s.custom[tag]; exists {
+			return append(chain, tag), tag != rootLocale || key == rootLocale
+		}
+		if _, exists := s.overlays[tag]; exists {
+			chain = append(chain, tag)
+			continue
+		}
+		if _, exists := s.data[tag]; exists {
+			return append(chain, tag), tag != rootLocale || key == rootLocale
+		}
 	}
+	return []string{fallbackLocale}, false
+}
 
-	data := loadLocaleData(locale)
-	localeCache[locale] = data
+// parentLocale возвращает родительскую локаль CLDR: "de-ch" -> "de", "en-in" -> "en-001"
+func parentLocale(tag string) string {
+	if parent, exists := localeParents[tag]; exists {
+		return parent
+	}
+	if i := strings.LastIndex(tag, "-"); i > 0 {
+		return tag[:i]
+	}
+	return ""
+}
+
+// build собирает данные по цепочке: копирует полные данные языка
+// (зарегистрированные или встроенные данные CLDR), накладывает региональные
+// варианты от общего к частному и дополняет валюты общими данными
+func (s *localeSnapshot) build(chain []string) *LocaleData {
+	base := chain[len(chain)-1]
+	data, registered := s.custom[base]
+	if !registered {
+		data = s.data[base]
+	}
+	data = data.clone()
+	for i := len(chain) - 2; i >= 0; i-- {
+		data.overlay(s.overlays[chain[i]])
+	}
+	data.resolveCurrencies()
 	return data
 }
 
-// loadLocaleData загружает данные локали из встроенных данных CLDR
-func loadLocaleData(locale string) *LocaleData {
-	// Нормализуем локаль
-	locale = normalizeLocale(locale)
+// clone возвращает полную копию данных локали, не разделяющую с ними
+// записи валют и компактных шаблонов
+func (d *LocaleData) clone() *LocaleData {
+	clone := *d
+	clone.CurrencyFormats = make(map[string]*CurrencyData, len(d.CurrencyFormats))
+	for code, currency := range d.CurrencyFormats {
+		copied := *currency
+		clone.CurrencyFormats[code] = &copied
+	}
+	clone.CompactPatterns = make(map[CompactRange]*CompactPattern, len(d.CompactPatterns))
+	for compactRange, pattern := range d.CompactPatterns {
+		copied := *pattern
+		clone.CompactPatterns[compactRange] = &copied
+	}
+	return &clone
+}
 
-	// Пробуем загрузить точное соответствие
-	if data := getExactLocaleData(locale); data != nil {
-		return data
+// overlay заменяет данные непустыми полями регионального варианта;
+// валюты и диапазоны компактной записи заменяются по ключам
+func (d *LocaleData) overlay(o *LocaleData) {
+	for _, field := range []struct{ dst, src *string }{
+		{&d.DecimalSeparator, &o.DecimalSeparator},
+		{&d.GroupSeparator, &o.GroupSeparator},
+		{&d.PercentSymbol, &o.PercentSymbol},
+		{&d.CurrencyPattern, &o.CurrencyPattern},
+		{&d.CurrencyNegativePattern, &o.CurrencyNegativePattern},
+		{&d.AccountingPattern, &o.AccountingPattern},
+		{&d.AccountingNegativePattern, &o.AccountingNegativePattern},
+		{&d.NegativePattern, &o.NegativePattern},
+		{&d.PositivePattern, &o.PositivePattern},
+		{&d.PercentPattern, &o.PercentPattern},
+		{&d.MinusSign, &o.MinusSign},
+		{&d.PlusSign, &o.PlusSign},
+		{&d.Exponential, &o.Exponential},
+		{&d.Infinity, &o.Infinity},
+		{&d.NaN, &o.NaN},
+		{&d.NumberingSystem, &o.NumberingSystem},
+		{&d.DefaultCurrency, &o.DefaultCurrency},
+	} {
+		if *field.src != "" {
+			*field.dst = *field.src
+		}
 	}
 
-	// Пробуем загрузить базовую локаль (без региона)
-	baseLocale := strings.Split(locale, "-")[0]
-	if data := getExactLocaleData(baseLocale); data != nil {
-		return data
+	if o.PrimaryGroupSize != 0 {
+		d.PrimaryGroupSize, d.SecondaryGroupSize = o.PrimaryGroupSize, o.SecondaryGroupSize
+	}
+	if o.MinimumGroupingDigits != 0 {
+		d.MinimumGroupingDigits = o.MinimumGroupingDigits
 	}
+	if o.SuperscriptingExponent {
+		d.SuperscriptingExponent = true
+	}
+
+	maps.Copy(d.CurrencyFormats, o.CurrencyFormats)
+	maps.Copy(d.CompactPatterns, o.CompactPatterns)
+}
 
-	// Fallback на английскую локаль
-	return getExactLocaleData("en")
+// currencyPatterns возвращает шаблоны положительной и отрицательной сумм
+// для записи знака sign
+func (d *LocaleData) currencyPatterns(sign CurrencySign) (positive, negative string) {
+	positive, negative = d.CurrencyPattern, d.CurrencyNegativePattern
+	if sign == CurrencySignAccounting {
+		if d.AccountingPattern != "" {
+			positive, negative = d.AccountingPattern, ""
+		}
+		if d.AccountingNegativePattern != "" {
+			negative = d.AccountingNegativePattern
+		}
+	}
+	if negative == "" {
+		negative = "{sign}" + positive
+	}
+	return positive, negative
 }
 
-// getExactLocaleData возвращает данные для конкретной локали
-func getExactLocaleData(locale string) *LocaleData {
-	if data, exists := localeData[locale]; exists {
-		// Обогащаем данные валют
-		for currencyCode := range data.CurrencyFormats {
-			if extendedData := getCurrencyData(currencyCode); extendedData != nil {
-				data.CurrencyFormats[currencyCode] = extendedData
+// resolveCurrencies заменяет данные валют копиями с шаблоном локали, а пустые
+// символ и название, minor units и шаги округления берет из общих данных
+func (d *LocaleData) resolveCurrencies() {
+	for currencyCode, currency := range d.CurrencyFormats {
+		resolved := *currency
+		if resolved.Format == "" {
+			resolved.Format = d.CurrencyPattern
+		}
+		if extendedData := getCurrencyData(currencyCode); extendedData != nil {
+			if resolved.Symbol == "" {
+				resolved.Symbol = extendedData.Symbol
+			}
+			if resolved.Name == "" {
+				resolved.Name = extendedData.Name
 			}
+			resolved.Digits = extendedData.Digits
+			resolved.Rounding = extendedData.Rounding
+			resolved.CashDigits = extendedData.CashDigits
+			resolved.CashRounding = extendedData.CashRounding
 		}
-		return data
+		d.CurrencyFormats[currencyCode] = &resolved
 	}
-	return nil
 }
 
 // normalizeLocale нормализует строку локали
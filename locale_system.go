@@ -4,8 +4,60 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"sync"
 )
 
+// LocaleDetector определяет локаль по умолчанию для форматтеров,
+// созданных без WithLocale
+type LocaleDetector interface {
+	DetectLocale() string
+}
+
+// LocaleDetectorFunc позволяет использовать функцию как LocaleDetector
+type LocaleDetectorFunc func() string
+
+// DetectLocale вызывает функцию
+func (f LocaleDetectorFunc) DetectLocale() string {
+	return f()
+}
+
+// systemLocaleDetector определяет локаль по окружению процесса
+var systemLocaleDetector LocaleDetector = LocaleDetectorFunc(getSystemLocale)
+
+// defaultLocaleDetector используется DefaultOptions
+var defaultLocaleDetector = systemLocaleDetector
+var detectorMutex sync.RWMutex
+
+// SetDefaultLocaleDetector задает способ определения локали по умолчанию;
+// nil возвращает определение по окружению процесса
+func SetDefaultLocaleDetector(detector LocaleDetector) {
+	if detector == nil {
+		detector = systemLocaleDetector
+	}
+	detectorMutex.Lock()
+	defaultLocaleDetector = detector
+	detectorMutex.Unlock()
+}
+
+// SetDefaultLocale фиксирует локаль по умолчанию, чтобы вывод не зависел
+// от окружения, в котором запущена программа
+func SetDefaultLocale(locale string) {
+	SetDefaultLocaleDetector(LocaleDetectorFunc(func() string { return locale }))
+}
+
+// defaultLocale возвращает локаль по умолчанию или запасную, если детектор
+// ничего не определил
+func defaultLocale() string {
+	detectorMutex.RLock()
+	detector := defaultLocaleDetector
+	detectorMutex.RUnlock()
+
+	if locale := detector.DetectLocale(); locale != "" {
+		return locale
+	}
+	return fallbackLocale
+}
+
 // getSystemLocale определяет системную локаль
 func getSystemLocale() string {
 	// Пробуем разные методы в зависимости от ОС
@@ -22,7 +74,7 @@ func getSystemLocale() string {
 // getUnixLocale получает локаль на Unix-системах
 func getUnixLocale() string {
 	// Пробуем переменные окружения в порядке приоритета
-	envVars := []string{"LC_ALL", "LC_NUMERIC", "LANG", "LANGUAGE"}
+	envVars := []string{"LC_ALL", "LC_NUMERIC", "LANG"}
 
 	for _, envVar := range envVars {
 		if locale := os.Getenv(envVar); locale != "" {
@@ -30,9 +82,33 @@ func getUnixLocale() string {
 		}
 	}
 
+	// LANGUAGE - список локалей через двоеточие по убыванию приоритета (GNU gettext)
+	if locale := languageListLocale(os.Getenv("LANGUAGE")); locale != "" {
+		return locale
+	}
+
 	return "en" // fallback
 }
 
+// languageListLocale возвращает первую поддерживаемую локаль из списка
+// LANGUAGE ("pt_BR:pt:en"), а если таких нет - первую непустую
+func languageListLocale(list string) string {
+	var first string
+	for _, entry := range strings.Split(list, ":") {
+		if entry == "" {
+			continue
+		}
+		locale := normalizeSystemLocale(entry)
+		if IsLocaleSupported(locale) {
+			return locale
+		}
+		if first == "" {
+			first = locale
+		}
+	}
+	return first
+}
+
 // getWindowsLocale получает локаль на Windows
 func getWindowsLocale() string {
 	// На Windows используем GetUserDefaultLocaleName из win32 API
@@ -56,13 +132,28 @@ func getMacOSLocale() string {
 // normalizeSystemLocale нормализует системную локаль
 func normalizeSystemLocale(locale string) string {
 	// Примеры входных форматов:
-	// en_US.UTF-8, ru_RU.UTF-8, en-US.UTF-8, es_ES, etc.
+	// en_US.UTF-8, ru_RU.UTF-8, en-US.UTF-8, es_ES, sr_RS@latin, C.UTF-8, etc.
+
+	// Модификатор POSIX может задавать письменность
+	locale, modifier, _ := strings.Cut(locale, "@")
 
 	// Убираем кодировку
 	if dotIndex := strings.Index(locale, "."); dotIndex != -1 {
 		locale = locale[:dotIndex]
 	}
 
+	// Локали C и POSIX не зависят от языка
+	if locale == "C" || locale == "POSIX" {
+		return rootLocale
+	}
+	if script, ok := modifierScripts[modifier]; ok {
+		if language, region, found := strings.Cut(locale, "_"); found {
+			locale = language + "_" + script + "_" + region
+		} else {
+			locale += "_" + script
+		}
+	}
+
 	// Заменяем подчеркивания на дефисы
 	locale = strings.Replace(locale, "_", "-", -1)
 
@@ -71,9 +162,20 @@ func normalizeSystemLocale(locale string) string {
 	if len(parts) > 0 {
 		parts[0] = strings.ToLower(parts[0])
 	}
-	if len(parts) > 1 {
-		parts[1] = strings.ToUpper(parts[1])
+	for i := 1; i < len(parts); i++ {
+		if len(parts[i]) == 4 {
+			parts[i] = strings.ToUpper(parts[i][:1]) + strings.ToLower(parts[i][1:])
+		} else {
+			parts[i] = strings.ToUpper(parts[i])
+		}
 	}
 
 	return strings.Join(parts, "-")
 }
+
+// modifierScripts сопоставляет модификаторы POSIX письменностям ("sr_RS@latin")
+var modifierScripts = map[string]string{
+	"latin":      "Latn",
+	"cyrillic":   "Cyrl",
+	"devanagari": "Deva",
+}
@@ -0,0 +1,77 @@
+//go:build !gonumfmt_minimal
+
+package gonumfmt
+
+import "testing"
+
+func TestNormalizeSystemLocale(t *testing.T) {
+	tests := map[string]string{
+		"en_US.UTF-8":          "en-US",
+		"de_CH":                "de-CH",
+		"es_419":               "es-419",
+		"sr_RS@latin":          "sr-Latn-RS",
+		"sr@latin":             "sr-Latn",
+		"uz_UZ.UTF-8@cyrillic": "uz-Cyrl-UZ",
+		"de_DE@euro":           "de-DE",
+		"C":                    "root",
+		"C.UTF-8":              "root",
+		"POSIX":                "root",
+	}
+	for locale, expected := range tests {
+		if result := normalizeSystemLocale(locale); result != expected {
+			t.Errorf("normalizeSystemLocale(%q) = %q, expected %q", locale, result, expected)
+		}
+	}
+}
+
+func TestUnixLocale(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected string
+	}{
+		{"LC_ALL wins", map[string]string{"LC_ALL": "de_DE.UTF-8", "LC_NUMERIC": "fr_FR", "LANG": "ru_RU"}, "de-DE"},
+		{"LC_NUMERIC before LANG", map[string]string{"LC_NUMERIC": "fr_FR", "LANG": "ru_RU"}, "fr-FR"},
+		{"LANG before LANGUAGE", map[string]string{"LANG": "ru_RU.UTF-8", "LANGUAGE": "de"}, "ru-RU"},
+		{"LANGUAGE list", map[string]string{"LANGUAGE": "pt_BR:pt:en"}, "pt-BR"},
+		{"LANGUAGE skips unsupported", map[string]string{"LANGUAGE": "xx:yy_ZZ:sv"}, "sv"},
+		{"LANGUAGE without supported", map[string]string{"LANGUAGE": "xx:yy"}, "xx"},
+		{"C locale", map[string]string{"LC_ALL": "C"}, "root"},
+		{"Nothing set", nil, "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"LC_ALL", "LC_NUMERIC", "LANG", "LANGUAGE"} {
+				t.Setenv(name, tt.env[name])
+			}
+			if result := getUnixLocale(); result != tt.expected {
+				t.Errorf("getUnixLocale() = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetDefaultLocale(t *testing.T) {
+	t.Cleanup(func() { SetDefaultLocaleDetector(nil) })
+
+	SetDefaultLocale("de")
+	if result := NewFormatter().Format(1234.5); result != "1.234,5" {
+		t.Errorf("Format with default locale de = %q, expected %q", result, "1.234,5")
+	}
+
+	SetDefaultLocaleDetector(LocaleDetectorFunc(func() string { return "fr" }))
+	if locale := DefaultOptions().Locale; locale != "fr" {
+		t.Errorf("DefaultOptions().Locale = %q, expected fr", locale)
+	}
+
+	SetDefaultLocaleDetector(LocaleDetectorFunc(func() string { return "" }))
+	if locale := DefaultOptions().Locale; locale != "en" {
+		t.Errorf("DefaultOptions().Locale with empty detection = %q, expected en", locale)
+	}
+
+	SetDefaultLocaleDetector(nil)
+	if locale := DefaultOptions().Locale; locale != getSystemLocale() {
+		t.Errorf("DefaultOptions().Locale = %q, expected system locale %q", locale, getSystemLocale())
+	}
+}
@@ -0,0 +1,292 @@
+//go:build !gonumfmt_minimal
+
+package gonumfmt
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestLocaleChain(t *testing.T) {
+	tests := []struct {
+		locale   string
+		expected []string
+	}{
+		{"de", []string{"de", "root"}},
+		{"de-CH", []string{"de-ch", "de", "root"}},
+		{"de_AT", []string{"de-at", "de", "root"}},
+		{"de-LI", []string{"de", "root"}},
+		{"en-IN", []string{"en-in", "en-001", "en", "root"}},
+		{"en-GB", []string{"en-gb", "en-001", "en", "root"}},
+		{"es-MX", []string{"es-mx", "es-419", "es", "root"}},
+		{"pt-BR", []string{"pt", "root"}},
+		{"fr-CA-u-nu-latn", []string{"fr-ca", "fr", "root"}},
+		{"de-CH-1996", []string{"de-ch", "de", "root"}},
+		{"zh-Hans-CN", []string{"zh", "root"}},
+		{"zh-Hant-TW", []string{"zh-hant", "root"}},
+		{"zh-TW", []string{"zh-hant", "root"}},
+		{"zh-HK", []string{"zh-hant-hk", "zh-hant", "root"}},
+		{"zh-MO", []string{"zh-hant-hk", "zh-hant", "root"}},
+		{"zh-SG", []string{"zh", "root"}},
+		{"sr-Latn", []string{"sr-latn", "root"}},
+		{"sr-ME", []string{"sr-latn", "root"}},
+		{"sr-Cyrl-RS", []string{"sr", "root"}},
+		{"iw-IL", []string{"he", "root"}},
+		{"no", []string{"nb", "root"}},
+		{"tl", []string{"fil", "root"}},
+		{"sh", []string{"sr-latn", "root"}},
+		{"deu-CH", []string{"de-ch", "de", "root"}},
+		{"en-UK", []string{"en-gb", "en-001", "en", "root"}},
+		{"es-484", []string{"es-mx", "es-419", "es", "root"}},
+		{"uz-Arab-AF", []string{"root"}},
+		{"root", []string{"root"}},
+		{"und", []string{"root"}},
+		{"und-u-nu-arab", []string{"root"}},
+		{"xx-YY", []string{"en", "root"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			if chain := LocaleChain(tt.locale); !slices.Equal(chain, tt.expected) {
+				t.Errorf("LocaleChain(%q) = %v, expected %v", tt.locale, chain, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseLocaleTag(t *testing.T) {
+	tests := []struct {
+		locale   string
+		expected localeTag
+	}{
+		{"en", localeTag{language: "en"}},
+		{"zh_Hant_TW", localeTag{language: "zh", script: "hant", region: "tw"}},
+		{"es-419", localeTag{language: "es", region: "419"}},
+		{"zh-yue-HK", localeTag{language: "yue", region: "hk"}},
+		{"de-CH-1901-x-old", localeTag{language: "de", region: "ch", variants: []string{"1901"}, extensions: []string{"x-old"}}},
+		{"sl-rozaj-biske", localeTag{language: "sl", variants: []string{"rozaj", "biske"}}},
+		{"ar-EG-u-nu-arab-t-en", localeTag{language: "ar", region: "eg", extensions: []string{"u-nu-arab", "t-en"}}},
+		{"en-US-u", localeTag{language: "en", region: "us"}},
+		{"", localeTag{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			tag := parseLocaleTag(tt.locale)
+			if tag.language != tt.expected.language || tag.script != tt.expected.script || tag.region != tt.expected.region ||
+				!slices.Equal(tag.variants, tt.expected.variants) || !slices.Equal(tag.extensions, tt.expected.extensions) {
+				t.Errorf("parseLocaleTag(%q) = %+v, expected %+v", tt.locale, tag, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMaximizeLocaleTag(t *testing.T) {
+	tests := map[string]string{
+		"zh":      "zh-hans-cn",
+		"zh-TW":   "zh-hant-tw",
+		"zh-Hant": "zh-hant-tw",
+		"sr-ME":   "sr-latn-me",
+		"de-CH":   "de-latn-ch",
+		"xx":      "xx",
+	}
+	for locale, expected := range tests {
+		if result := parseLocaleTag(locale).maximize().String(); result != expected {
+			t.Errorf("maximize(%q) = %q, expected %q", locale, result, expected)
+		}
+	}
+}
+
+func TestRegionalLocales(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   float64
+		locale   string
+		opts     []FormatterOption
+		expected string
+	}{
+		{"Swiss German grouping", 1234567.891, "de-CH", nil, "1’234’567.891"},
+		{"Austrian German grouping", 1234567.891, "de-AT", nil, "1 234 567,891"},
+		{"Swiss German inherits compact", 1500000, "de-CH", []FormatterOption{WithStyle(Compact)}, "1.5 Mio."},
+		{"Swiss German currency", 1234.56, "de-CH", []FormatterOption{WithStyle(Currency), WithCurrency("EUR")}, "EUR 1’234.56"},
+		{"Austrian German currency", 1234.56, "de-AT", []FormatterOption{WithStyle(Currency), WithCurrency("EUR")}, "€ 1 234,56"},
+		{"British English pound", 1234.56, "en-GB", []FormatterOption{WithStyle(Currency), WithCurrency("GBP")}, "£1,234.56"},
+		{"British English dollar", 1234.56, "en-GB", []FormatterOption{WithStyle(Currency), WithCurrency("USD")}, "US$1,234.56"},
+		{"Australian English dollar", 1234.56, "en-AU", []FormatterOption{WithStyle(Currency), WithCurrency("AUD")}, "$1,234.56"},
+		{"Canadian French dollar", 1234.56, "fr-CA", []FormatterOption{WithStyle(Currency), WithCurrency("CAD")}, "1 234,56 $"},
+		{"Canadian French billion", 1.5e9, "fr-CA", []FormatterOption{WithStyle(Compact)}, "1,5 G"},
+		{"French billion", 1.5e9, "fr", []FormatterOption{WithStyle(Compact)}, "1,5 Md"},
+		{"Mexican Spanish grouping", 1234, "es-MX", nil, "1,234"},
+		{"Spanish grouping", 1234, "es", nil, "1234"},
+		{"Mexican Spanish peso", 1234.56, "es-MX", []FormatterOption{WithStyle(Currency), WithCurrency("MXN")}, "$1,234.56"},
+		{"Brazilian Portuguese", 1234.5, "pt-BR", nil, "1.234,5"},
+		{"European Portuguese", 1234.5, "pt-PT", nil, "1234,5"},
+		{"European Portuguese groups", 12345.5, "pt-PT", nil, "12 345,5"},
+		{"Indian English crore instead of billion", 1.5e9, "en-IN", []FormatterOption{WithStyle(Compact)}, "150Cr"},
+		{"Traditional Chinese ten thousand", 15000, "zh-TW", []FormatterOption{WithStyle(Compact)}, "1.5萬"},
+		{"Simplified Chinese ten thousand", 15000, "zh-Hans-CN", []FormatterOption{WithStyle(Compact)}, "1.5万"},
+		{"Hong Kong Chinese thousand", 15000, "zh-Hant-HK", []FormatterOption{WithStyle(Compact)}, "15K"},
+		{"Taiwan dollar", 1234.5, "zh-TW", []FormatterOption{WithStyle(Currency), WithCurrency("TWD")}, "$1,234.50"},
+		{"Serbian Latin", 1.5e6, "sr-Latn", []FormatterOption{WithStyle(Compact)}, "1,5 mil."},
+		{"Serbian Cyrillic", 1.5e6, "sr-Cyrl", []FormatterOption{WithStyle(Compact)}, "1,5 мил."},
+		{"Montenegrin Serbian is Latin", 1.5e6, "sr-ME", []FormatterOption{WithStyle(Compact)}, "1,5 mil."},
+		{"Norwegian alias", 1234.5, "no", nil, "1 234,5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFormatter(append([]FormatterOption{WithLocale(tt.locale)}, tt.opts...)...)
+			if result := f.Format(tt.number); result != tt.expected {
+				t.Errorf("Format(%v) in %s = %q, expected %q", tt.number, tt.locale, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRegionalLocaleData(t *testing.T) {
+	data := GetLocaleData("de-CH")
+	if data.DefaultCurrency != "CHF" {
+		t.Errorf("de-CH DefaultCurrency = %q, expected CHF", data.DefaultCurrency)
+	}
+
+	// Разрешенные данные не разделяют записи валют со встроенными таблицами
+	eur := data.CurrencyFormats["EUR"]
+	if eur == locales.Load().overlays["de-ch"].CurrencyFormats["EUR"] {
+		t.Error("GetLocaleData returned a generated currency entry")
+	}
+	if eur.Format != "{symbol} {number}" || eur.Digits != 2 {
+		t.Errorf("de-CH EUR = %+v, expected locale format and 2 digits", eur)
+	}
+	if gbp := data.CurrencyFormats["GBP"]; gbp == nil || gbp.Format != "{symbol} {number}" {
+		t.Errorf("de-CH GBP = %+v, expected inherited entry with de-CH format", gbp)
+	}
+
+	if de := GetLocaleData("de"); de.GroupSeparator != "." || de.CurrencyFormats["EUR"].Symbol != "€" {
+		t.Errorf("de data changed by de-CH overlay: %q, %q", de.GroupSeparator, de.CurrencyFormats["EUR"].Symbol)
+	}
+
+	for locale, expected := range map[string]string{"zh": "CNY", "zh-TW": "TWD", "zh-HK": "HKD", "sr-Latn": "RSD"} {
+		if currency := GetLocaleData(locale).DefaultCurrency; currency != expected {
+			t.Errorf("%s DefaultCurrency = %q, expected %s", locale, currency, expected)
+		}
+	}
+}
+
+func TestIsLocaleSupported(t *testing.T) {
+	tests := map[string]bool{
+		"de":              true,
+		"de-CH":           true,
+		"de-LI":           true,
+		"en_IN":           true,
+		"zh-u-nu-hanidec": true,
+		"zh-Hant-TW":      true,
+		"iw":              true,
+		"uz-Arab":         false,
+		"root":            true,
+		"und":             true,
+		"xx":              false,
+		"":                false,
+	}
+	for locale, expected := range tests {
+		if result := IsLocaleSupported(locale); result != expected {
+			t.Errorf("IsLocaleSupported(%q) = %v, expected %v", locale, result, expected)
+		}
+	}
+
+	supported := SupportedLocales()
+	for _, locale := range []string{"de", "de-ch", "en-001", "es-419", "zh-hant", "sr-latn"} {
+		if !slices.Contains(supported, locale) {
+			t.Errorf("SupportedLocales() does not contain %s", locale)
+		}
+	}
+}
+
+func TestGetLocaleDataCopy(t *testing.T) {
+	data := GetLocaleData("de")
+	data.GroupSeparator = "?"
+	data.CurrencyFormats["EUR"].Symbol = "?"
+	data.CompactPatterns[Thousand].Short = "?"
+
+	f := NewFormatter(WithLocale("de"), WithStyle(Currency), WithCurrency("EUR"))
+	if result := f.Format(1234.56); result != "1.234,56 €" {
+		t.Errorf("Format after changing GetLocaleData copy = %q, expected %q", result, "1.234,56 €")
+	}
+	if de := GetLocaleData("de"); de.GroupSeparator != "." || de.CurrencyFormats["EUR"].Symbol != "€" || de.CompactPatterns[Thousand].Short == "?" {
+		t.Error("GetLocaleData returned shared data")
+	}
+}
+
+func TestLookupCacheKey(t *testing.T) {
+	current := locales.Load()
+	s := newLocaleSnapshot(current.data, current.overlays, current.custom)
+
+	resolved := len(s.resolved)
+
+	spellings := []string{"de-LI", "de_LI", "DE-li", "de-Latn-LI", "de-LI-u-nu-latn", "de-LI-x-private", "de-1996", "de-LI-fonipa"}
+	for i := range 100 {
+		spellings = append(spellings, fmt.Sprintf("de-LI-x-%d", i), fmt.Sprintf("de-%08d", i))
+	}
+	for _, locale := range spellings {
+		if data := s.lookup(locale); data != s.lookup("de") {
+			t.Errorf("lookup(%q) did not return de data", locale)
+		}
+	}
+	if data := s.lookup("de-CH-1996"); data != s.lookup("de-CH") {
+		t.Error("lookup(de-CH-1996) did not return de-CH data")
+	}
+
+	if len(s.resolved) != resolved {
+		t.Errorf("lookups added %d resolved entries", len(s.resolved)-resolved)
+	}
+}
+
+// TestConcurrentFormatters запускается с -race: форматтеры создаются и
+// используются параллельно с регистрацией локалей и изменением копий данных
+func TestConcurrentFormatters(t *testing.T) {
+	unregisterLocales(t)
+
+	locales := SupportedLocales()
+	expected := make(map[string]string, len(locales))
+	for _, locale := range locales {
+		expected[locale] = NewFormatter(WithLocale(locale), WithStyle(Currency)).Format(-1234567.891)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			data := GetLocaleData("en")
+			data.GroupSeparator = "'"
+			if err := RegisterLocale("tlh", data); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+
+	for _, locale := range locales {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				f := NewFormatter(WithLocale(locale), WithStyle(Currency))
+				if result := f.Format(-1234567.891); result != expected[locale] {
+					t.Errorf("concurrent Format in %s = %q, expected %q", locale, result, expected[locale])
+					return
+				}
+				NewFormatter(WithLocale(locale), WithStyle(Compact)).Format(1234567)
+				NewFormatter(WithLocale(locale)).Parse(NewFormatter(WithLocale(locale)).Format(1234.5))
+
+				data := GetLocaleData(locale)
+				data.DecimalSeparator = "?"
+				for _, currency := range data.CurrencyFormats {
+					currency.Symbol = "?"
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
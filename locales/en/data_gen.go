@@ -0,0 +1,239 @@
+// Code generated by cldrgen from CLDR data; DO NOT EDIT.
+
+// Пакет en содержит данные CLDR для локалей en, en-001, en-AU, en-CA, en-GB, en-IN.
+// Пакет gonumfmt подключает его всегда.
+package en
+
+import "github.com/madebydima/gonumfmt/internal/localedata"
+
+func init() {
+	localedata.Register(&localedata.Pack{
+		Language: "en",
+		Locales: map[string]*localedata.Locale{
+			"en": {
+				DecimalSeparator:          ".",
+				GroupSeparator:            ",",
+				PercentSymbol:             "%",
+				CurrencyPattern:           "{symbol}{number}",
+				AccountingNegativePattern: "({symbol}{number})",
+				NegativePattern:           "{sign}{number}",
+				PositivePattern:           "{sign}{number}",
+				PercentPattern:            "{number}{symbol}",
+				MinusSign:                 "-",
+				PlusSign:                  "+",
+				Exponential:               "E",
+				DefaultCurrency:           "USD",
+				Currencies: map[string]localedata.Currency{
+					"AED": {Symbol: "AED", Name: "UAE Dirham"},
+					"AFN": {Symbol: "AFN", Name: "Afghan Afghani"},
+					"ALL": {Symbol: "ALL", Name: "Albanian Lek"},
+					"AMD": {Symbol: "AMD", Name: "Armenian Dram"},
+					"ANG": {Symbol: "ANG", Name: "Netherlands Antillean Guilder"},
+					"AOA": {Symbol: "AOA", Name: "Angolan Kwanza"},
+					"ARS": {Symbol: "ARS", Name: "Argentine Peso"},
+					"AUD": {Symbol: "A$", Name: "Australian Dollar"},
+					"AWG": {Symbol: "AWG", Name: "Aruban Florin"},
+					"AZN": {Symbol: "AZN", Name: "Azerbaijani Manat"},
+					"BAM": {Symbol: "BAM", Name: "Bosnia-Herzegovina Convertible Mark"},
+					"BBD": {Symbol: "BBD", Name: "Barbadian Dollar"},
+					"BDT": {Symbol: "BDT", Name: "Bangladeshi Taka"},
+					"BGN": {Symbol: "BGN", Name: "Bulgarian Lev"},
+					"BHD": {Symbol: "BHD", Name: "Bahraini Dinar"},
+					"BIF": {Symbol: "BIF", Name: "Burundian Franc"},
+					"BMD": {Symbol: "BMD", Name: "Bermudan Dollar"},
+					"BND": {Symbol: "BND", Name: "Brunei Dollar"},
+					"BOB": {Symbol: "BOB", Name: "Bolivian Boliviano"},
+					"BOV": {Symbol: "BOV", Name: "Bolivian Mvdol"},
+					"BRL": {Symbol: "R$", Name: "Brazilian Real"},
+					"BSD": {Symbol: "BSD", Name: "Bahamian Dollar"},
+					"BTN": {Symbol: "BTN", Name: "Bhutanese Ngultrum"},
+					"BWP": {Symbol: "BWP", Name: "Botswanan Pula"},
+					"BYN": {Symbol: "BYN", Name: "Belarusian Ruble"},
+					"BZD": {Symbol: "BZD", Name: "Belize Dollar"},
+					"CAD": {Symbol: "CA$", Name: "Canadian Dollar"},
+					"CDF": {Symbol: "CDF", Name: "Congolese Franc"},
+					"CHE": {Symbol: "CHE", Name: "WIR Euro"},
+					"CHF": {Symbol: "CHF", Name: "Swiss Franc"},
+					"CHW": {Symbol: "CHW", Name: "WIR Franc"},
+					"CLF": {Symbol: "CLF", Name: "Chilean Unit of Account (UF)"},
+					"CLP": {Symbol: "CLP", Name: "Chilean Peso"},
+					"CNY": {Symbol: "CN¥", Name: "Chinese Yuan"},
+					"COP": {Symbol: "COP", Name: "Colombian Peso"},
+					"COU": {Symbol: "COU", Name: "Colombian Real Value Unit"},
+					"CRC": {Symbol: "CRC", Name: "Costa Rican Colón"},
+					"CUP": {Symbol: "CUP", Name: "Cuban Peso"},
+					"CVE": {Symbol: "CVE", Name: "Cape Verdean Escudo"},
+					"CZK": {Symbol: "CZK", Name: "Czech Koruna"},
+					"DJF": {Symbol: "DJF", Name: "Djiboutian Franc"},
+					"DKK": {Symbol: "DKK", Name: "Danish Krone"},
+					"DOP": {Symbol: "DOP", Name: "Dominican Peso"},
+					"DZD": {Symbol: "DZD", Name: "Algerian Dinar"},
+					"EGP": {Symbol: "EGP", Name: "Egyptian Pound"},
+					"ERN": {Symbol: "ERN", Name: "Eritrean Nakfa"},
+					"ETB": {Symbol: "ETB", Name: "Ethiopian Birr"},
+					"EUR": {Symbol: "€", Name: "Euro"},
+					"FJD": {Symbol: "FJD", Name: "Fijian Dollar"},
+					"FKP": {Symbol: "FKP", Name: "Falkland Islands Pound"},
+					"GBP": {Symbol: "£", Name: "British Pound"},
+					"GEL": {Symbol: "GEL", Name: "Georgian Lari"},
+					"GHS": {Symbol: "GHS", Name: "Ghanaian Cedi"},
+					"GIP": {Symbol: "GIP", Name: "Gibraltar Pound"},
+					"GMD": {Symbol: "GMD", Name: "Gambian Dalasi"},
+					"GNF": {Symbol: "GNF", Name: "Guinean Franc"},
+					"GTQ": {Symbol: "GTQ", Name: "Guatemalan Quetzal"},
+					"GYD": {Symbol: "GYD", Name: "Guyanaese Dollar"},
+					"HKD": {Symbol: "HK$", Name: "Hong Kong Dollar"},
+					"HNL": {Symbol: "HNL", Name: "Honduran Lempira"},
+					"HTG": {Symbol: "HTG", Name: "Haitian Gourde"},
+					"HUF": {Symbol: "HUF", Name: "Hungarian Forint"},
+					"IDR": {Symbol: "IDR", Name: "Indonesian Rupiah"},
+					"ILS": {Symbol: "₪", Name: "Israeli New Shekel"},
+					"INR": {Symbol: "₹", Name: "Indian Rupee"},
+					"IQD": {Symbol: "IQD", Name: "Iraqi Dinar"},
+					"IRR": {Symbol: "IRR", Name: "Iranian Rial"},
+					"ISK": {Symbol: "ISK", Name: "Icelandic Króna"},
+					"JMD": {Symbol: "JMD", Name: "Jamaican Dollar"},
+					"JOD": {Symbol: "JOD", Name: "Jordanian Dinar"},
+					"JPY": {Symbol: "¥", Name: "Japanese Yen"},
+					"KES": {Symbol: "KES", Name: "Kenyan Shilling"},
+					"KGS": {Symbol: "KGS", Name: "Kyrgystani Som"},
+					"KHR": {Symbol: "KHR", Name: "Cambodian Riel"},
+					"KMF": {Symbol: "KMF", Name: "Comorian Franc"},
+					"KPW": {Symbol: "KPW", Name: "North Korean Won"},
+					"KRW": {Symbol: "₩", Name: "South Korean Won"},
+					"KWD": {Symbol: "KWD", Name: "Kuwaiti Dinar"},
+					"KYD": {Symbol: "KYD", Name: "Cayman Islands Dollar"},
+					"KZT": {Symbol: "KZT", Name: "Kazakhstani Tenge"},
+					"LAK": {Symbol: "LAK", Name: "Laotian Kip"},
+					"LBP": {Symbol: "LBP", Name: "Lebanese Pound"},
+					"LKR": {Symbol: "LKR", Name: "Sri Lankan Rupee"},
+					"LRD": {Symbol: "LRD", Name: "Liberian Dollar"},
+					"LSL": {Symbol: "LSL", Name: "Lesotho Loti"},
+					"LYD": {Symbol: "LYD", Name: "Libyan Dinar"},
+					"MAD": {Symbol: "MAD", Name: "Moroccan Dirham"},
+					"MDL": {Symbol: "MDL", Name: "Moldovan Leu"},
+					"MGA": {Symbol: "MGA", Name: "Malagasy Ariary"},
+					"MKD": {Symbol: "MKD", Name: "Macedonian Denar"},
+					"MMK": {Symbol: "MMK", Name: "Myanmar Kyat"},
+					"MNT": {Symbol: "MNT", Name: "Mongolian Tugrik"},
+					"MOP": {Symbol: "MOP", Name: "Macanese Pataca"},
+					"MRU": {Symbol: "MRU", Name: "Mauritanian Ouguiya"},
+					"MUR": {Symbol: "MUR", Name: "Mauritian Rupee"},
+					"MVR": {Symbol: "MVR", Name: "Maldivian Rufiyaa"},
+					"MWK": {Symbol: "MWK", Name: "Malawian Kwacha"},
+					"MXN": {Symbol: "MX$", Name: "Mexican Peso"},
+					"MXV": {Symbol: "MXV", Name: "Mexican Investment Unit"},
+					"MYR": {Symbol: "MYR", Name: "Malaysian Ringgit"},
+					"MZN": {Symbol: "MZN", Name: "Mozambican Metical"},
+					"NAD": {Symbol: "NAD", Name: "Namibian Dollar"},
+					"NGN": {Symbol: "NGN", Name: "Nigerian Naira"},
+					"NIO": {Symbol: "NIO", Name: "Nicaraguan Córdoba"},
+					"NOK": {Symbol: "NOK", Name: "Norwegian Krone"},
+					"NPR": {Symbol: "NPR", Name: "Nepalese Rupee"},
+					"NZD": {Symbol: "NZ$", Name: "New Zealand Dollar"},
+					"OMR": {Symbol: "OMR", Name: "Omani Rial"},
+					"PAB": {Symbol: "PAB", Name: "Panamanian Balboa"},
+					"PEN": {Symbol: "PEN", Name: "Peruvian Sol"},
+					"PGK": {Symbol: "PGK", Name: "Papua New Guinean Kina"},
+					"PHP": {Symbol: "₱", Name: "Philippine Peso"},
+					"PKR": {Symbol: "PKR", Name: "Pakistani Rupee"},
+					"PLN": {Symbol: "PLN", Name: "Polish Zloty"},
+					"PYG": {Symbol: "PYG", Name: "Paraguayan Guarani"},
+					"QAR": {Symbol: "QAR", Name: "Qatari Riyal"},
+					"RON": {Symbol: "RON", Name: "Romanian Leu"},
+					"RSD": {Symbol: "RSD", Name: "Serbian Dinar"},
+					"RUB": {Symbol: "RUB", Name: "Russian Ruble"},
+					"RWF": {Symbol: "RWF", Name: "Rwandan Franc"},
+					"SAR": {Symbol: "SAR", Name: "Saudi Riyal"},
+					"SBD": {Symbol: "SBD", Name: "Solomon Islands Dollar"},
+					"SCR": {Symbol: "SCR", Name: "Seychellois Rupee"},
+					"SDG": {Symbol: "SDG", Name: "Sudanese Pound"},
+					"SEK": {Symbol: "SEK", Name: "Swedish Krona"},
+					"SGD": {Symbol: "SGD", Name: "Singapore Dollar"},
+					"SHP": {Symbol: "SHP", Name: "St. Helena Pound"},
+					"SLE": {Symbol: "SLE", Name: "Sierra Leonean Leone"},
+					"SOS": {Symbol: "SOS", Name: "Somali Shilling"},
+					"SRD": {Symbol: "SRD", Name: "Surinamese Dollar"},
+					"SSP": {Symbol: "SSP", Name: "South Sudanese Pound"},
+					"STN": {Symbol: "STN", Name: "São Tomé & Príncipe Dobra"},
+					"SVC": {Symbol: "SVC", Name: "Salvadoran Colón"},
+					"SYP": {Symbol: "SYP", Name: "Syrian Pound"},
+					"SZL": {Symbol: "SZL", Name: "Swazi Lilangeni"},
+					"THB": {Symbol: "THB", Name: "Thai Baht"},
+					"TJS": {Symbol: "TJS", Name: "Tajikistani Somoni"},
+					"TMT": {Symbol: "TMT", Name: "Turkmenistani Manat"},
+					"TND": {Symbol: "TND", Name: "Tunisian Dinar"},
+					"TOP": {Symbol: "TOP", Name: "Tongan Paʻanga"},
+					"TRY": {Symbol: "TRY", Name: "Turkish Lira"},
+					"TTD": {Symbol: "TTD", Name: "Trinidad & Tobago Dollar"},
+					"TWD": {Symbol: "NT$", Name: "New Taiwan Dollar"},
+					"TZS": {Symbol: "TZS", Name: "Tanzanian Shilling"},
+					"UAH": {Symbol: "UAH", Name: "Ukrainian Hryvnia"},
+					"UGX": {Symbol: "UGX", Name: "Ugandan Shilling"},
+					"USD": {Symbol: "$", Name: "US Dollar"},
+					"USN": {Symbol: "USN", Name: "US Dollar (Next day)"},
+					"UYI": {Symbol: "UYI", Name: "Uruguayan Peso (Indexed Units)"},
+					"UYU": {Symbol: "UYU", Name: "Uruguayan Peso"},
+					"UYW": {Symbol: "UYW", Name: "Uruguayan Nominal Wage Index Unit"},
+					"UZS": {Symbol: "UZS", Name: "Uzbekistani Som"},
+					"VES": {Symbol: "VES", Name: "Venezuelan Bolívar"},
+					"VND": {Symbol: "₫", Name: "Vietnamese Dong"},
+					"VUV": {Symbol: "VUV", Name: "Vanuatu Vatu"},
+					"WST": {Symbol: "WST", Name: "Samoan Tala"},
+					"XAF": {Symbol: "FCFA", Name: "Central African CFA Franc"},
+					"XCD": {Symbol: "EC$", Name: "East Caribbean Dollar"},
+					"XOF": {Symbol: "F CFA", Name: "West African CFA Franc"},
+					"XPF": {Symbol: "CFPF", Name: "CFP Franc"},
+					"YER": {Symbol: "YER", Name: "Yemeni Rial"},
+					"ZAR": {Symbol: "ZAR", Name: "South African Rand"},
+					"ZMW": {Symbol: "ZMW", Name: "Zambian Kwacha"},
+					"ZWG": {Symbol: "ZWG", Name: "Zimbabwean Gold"},
+				},
+				CompactPatterns: map[int]localedata.CompactPattern{
+					3:  {Short: "0K", Long: "0 thousand"},
+					6:  {Short: "0M", Long: "0 million"},
+					9:  {Short: "0B", Long: "0 billion"},
+					12: {Short: "0T", Long: "0 trillion"},
+				},
+			},
+		},
+		Overlays: map[string]*localedata.Locale{
+			"en-001": {
+				Currencies: map[string]localedata.Currency{
+					"USD": {Symbol: "US$", Name: "US Dollar"},
+				},
+			},
+			"en-au": {
+				DefaultCurrency: "AUD",
+				Currencies: map[string]localedata.Currency{
+					"AUD": {Symbol: "$", Name: "Australian Dollar"},
+					"USD": {Symbol: "USD", Name: "US Dollar"},
+				},
+			},
+			"en-ca": {
+				DefaultCurrency: "CAD",
+				Currencies: map[string]localedata.Currency{
+					"CAD": {Symbol: "$", Name: "Canadian Dollar"},
+				},
+			},
+			"en-gb": {
+				DefaultCurrency: "GBP",
+			},
+			"en-in": {
+				PrimaryGroupSize:   3,
+				SecondaryGroupSize: 2,
+				DefaultCurrency:    "INR",
+				Currencies: map[string]localedata.Currency{
+					"USD": {Symbol: "$", Name: "US Dollar"},
+				},
+				CompactPatterns: map[int]localedata.CompactPattern{
+					5:  {Short: "0L", Long: "0 lakh"},
+					6:  {Short: "", Long: ""},
+					7:  {Short: "0Cr", Long: "0 crore"},
+					9:  {Short: "", Long: ""},
+					12: {Short: "0LCr", Long: "0 lakh crore"},
+				},
+			},
+		},
+	})
+}
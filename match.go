@@ -0,0 +1,152 @@
+package gonumfmt
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MatchLocale выбирает по заголовку Accept-Language лучшую локаль из списка
+// supported. Языки перебираются по убыванию q; для каждого сначала ищется
+// локаль из его цепочки наследования (как в GetLocaleData: "de-LI" -> "de"),
+// затем любая локаль того же языка и письменности ("en-US" -> "en-GB").
+// Возвращается элемент supported в исходном виде; если совпадений нет,
+// первая поддерживаемая gonumfmt локаль списка.
+//
+// Пустой supported означает все локали gonumfmt: тогда возвращается ключ
+// данных, к которым сводится тег из заголовка ("de" для "de-LI"), а без
+// совпадений - локаль по умолчанию (SetDefaultLocale, SetDefaultLocaleDetector).
+func MatchLocale(acceptLanguage string, supported []string) string {
+	if locale, ok := matchLocale(acceptLanguage, supported); ok {
+		return locale
+	}
+	for _, locale := range supported {
+		if IsLocaleSupported(locale) {
+			return locale
+		}
+	}
+	return canonicalLocale(defaultLocale())
+}
+
+// matchLocale выбирает локаль по заголовку Accept-Language и сообщает,
+// найдено ли совпадение
+func matchLocale(acceptLanguage string, supported []string) (string, bool) {
+	var candidates []string
+	for _, locale := range supported {
+		if IsLocaleSupported(locale) {
+			candidates = append(candidates, locale)
+		}
+	}
+
+	for _, desired := range parseAcceptLanguage(acceptLanguage) {
+		if desired == "*" {
+			if len(candidates) > 0 {
+				return candidates[0], true
+			}
+			continue
+		}
+		if len(supported) == 0 {
+			if IsLocaleSupported(desired) {
+				return canonicalLocale(desired), true
+			}
+			continue
+		}
+		if match, ok := matchCandidate(desired, candidates); ok {
+			return match, true
+		}
+	}
+	return "", false
+}
+
+// canonicalLocale возвращает ключ данных, к которым сводится тег, сохраняя
+// известную числовую систему из расширения "-u-nu-". Так значения из запроса
+// сводятся к конечному набору локалей: "EN_us-x-1" и "en-US" дают "en".
+func canonicalLocale(locale string) string {
+	chain, _ := localeChain(locale)
+	key := chain[0]
+	if nu := strings.ToLower(unicodeExtension(locale, "nu")); nu == "latn" || numberingSystems[nu] != nil {
+		key += "-u-nu-" + nu
+	}
+	return key
+}
+
+// NewFormatterFromAcceptLanguage создает форматтер для локали, выбранной
+// по заголовку Accept-Language среди всех локалей gonumfmt
+func NewFormatterFromAcceptLanguage(acceptLanguage string, opts ...FormatterOption) *Formatter {
+	locale := MatchLocale(acceptLanguage, nil)
+	return NewFormatter(append([]FormatterOption{WithLocale(locale)}, opts...)...)
+}
+
+// matchCandidate ищет для тега локаль из его цепочки наследования, а затем
+// локаль с теми же полными данными языка
+func matchCandidate(desired string, candidates []string) (string, bool) {
+	for tag := languageTag(desired); tag != ""; tag = parentLocale(tag) {
+		for _, candidate := range candidates {
+			if languageTag(candidate) == tag {
+				return candidate, true
+			}
+		}
+	}
+
+	language := dataLanguage(desired)
+	if language == "" {
+		return "", false
+	}
+	for _, candidate := range candidates {
+		if dataLanguage(candidate) == language {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// dataLanguage возвращает ключ полных данных языка, на которых основана
+// локаль ("zh-hant" для "zh-TW"), или пустую строку для неподдерживаемой
+func dataLanguage(locale string) string {
+	chain, found := localeChain(locale)
+	if !found {
+		return ""
+	}
+	return chain[len(chain)-1]
+}
+
+// parseAcceptLanguage возвращает теги заголовка Accept-Language по убыванию q.
+// Теги с q=0 и с некорректным q отбрасываются, при равном q сохраняется порядок.
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+
+	var ranges []weighted
+	for _, part := range strings.Split(header, ",") {
+		params := strings.Split(part, ";")
+		tag := strings.TrimSpace(params[0])
+		if tag == "" || tag != "*" && parseLocaleTag(tag).language == "" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range params[1:] {
+			name, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+			if strings.TrimSpace(name) != "q" {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || parsed < 0 || parsed > 1 {
+				parsed = 0
+			}
+			q = parsed
+		}
+		if q > 0 {
+			ranges = append(ranges, weighted{tag, q})
+		}
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].q > ranges[j].q })
+	tags := make([]string, len(ranges))
+	for i, r := range ranges {
+		tags[i] = r.tag
+	}
+	return tags
+}
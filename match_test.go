@@ -0,0 +1,97 @@
+//go:build !gonumfmt_minimal
+
+package gonumfmt
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected []string
+	}{
+		{"de-CH", []string{"de-CH"}},
+		{"fr;q=0.5, en-US, de;q=0.8", []string{"en-US", "de", "fr"}},
+		{"en;q=0.8, fr;q=0.8", []string{"en", "fr"}},
+		{"en, *;q=0.1", []string{"en", "*"}},
+		{"da, en-gb;q=0, en;q=abc, ru;q=2", []string{"da"}},
+		{" es-MX ; q=0.9 , , x-klingon", []string{"es-MX"}},
+		{"", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			if result := parseAcceptLanguage(tt.header); !slices.Equal(result, tt.expected) {
+				t.Errorf("parseAcceptLanguage(%q) = %q, expected %q", tt.header, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMatchLocale(t *testing.T) {
+	SetDefaultLocale("en")
+	t.Cleanup(func() { SetDefaultLocaleDetector(nil) })
+
+	tests := []struct {
+		name      string
+		header    string
+		supported []string
+		expected  string
+	}{
+		{"Exact match", "de-CH", []string{"en", "de", "de-CH"}, "de-CH"},
+		{"Parent match", "de-LI, en;q=0.5", []string{"en", "de"}, "de"},
+		{"CLDR parent", "en-IN", []string{"en", "en-001"}, "en-001"},
+		{"q order", "fr;q=0.5, de;q=0.9", []string{"en", "fr", "de"}, "de"},
+		{"Same language preferred over next language", "en-US, fr;q=0.9", []string{"fr", "en-GB"}, "en-GB"},
+		{"Chain match before sibling", "en-US", []string{"en-GB", "en"}, "en"},
+		{"Script mismatch", "zh-TW, ja;q=0.5", []string{"zh-CN", "ja"}, "ja"},
+		{"Script match", "zh-HK", []string{"zh-CN", "zh-Hant"}, "zh-Hant"},
+		{"Alias", "iw", []string{"en", "he"}, "he"},
+		{"Supported in another form", "pt-pt", []string{"pt", "pt_PT"}, "pt_PT"},
+		{"Wildcard", "xx, *;q=0.1", []string{"ru", "en"}, "ru"},
+		{"No match returns default", "ja", []string{"ru", "en"}, "ru"},
+		{"Unsupported entries skipped", "xx", []string{"yy", "en"}, "en"},
+		{"Empty header", "", []string{"de", "en"}, "de"},
+		{"All locales", "xx, de-LI;q=0.8", nil, "de"},
+		{"All locales canonical key", "EN_in-x-private", nil, "en-in"},
+		{"All locales keep numbering system", "ar-EG-u-nu-latn", nil, "ar-u-nu-latn"},
+		{"All locales drop unknown numbering system", "hi-u-nu-klingon", nil, "hi"},
+		{"All locales without match", "xx", nil, "en"},
+		{"No supported locales", "xx", []string{"yy"}, "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := MatchLocale(tt.header, tt.supported); result != tt.expected {
+				t.Errorf("MatchLocale(%q, %q) = %q, expected %q", tt.header, tt.supported, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMatchLocaleDefault(t *testing.T) {
+	SetDefaultLocale("de-CH")
+	t.Cleanup(func() { SetDefaultLocaleDetector(nil) })
+
+	if locale := MatchLocale("", nil); locale != "de-ch" {
+		t.Errorf("MatchLocale without header = %q, expected default de-ch", locale)
+	}
+	if locale := MatchLocale("xx", nil); locale != "de-ch" {
+		t.Errorf("MatchLocale(xx) = %q, expected default de-ch", locale)
+	}
+	if locale := MatchLocale("", []string{"fr"}); locale != "fr" {
+		t.Errorf("MatchLocale with supported locales = %q, expected fr", locale)
+	}
+	if result := NewFormatterFromAcceptLanguage("").Format(1234.5); result != "1’234.5" {
+		t.Errorf("NewFormatterFromAcceptLanguage(\"\") Format = %q, expected %q", result, "1’234.5")
+	}
+}
+
+func TestNewFormatterFromAcceptLanguage(t *testing.T) {
+	f := NewFormatterFromAcceptLanguage("xx, de-CH;q=0.9, en;q=0.5", WithStyle(Currency), WithCurrency("CHF"))
+	if result := f.Format(1234.56); result != "CHF 1’234.56" {
+		t.Errorf("Format(1234.56) = %q, expected %q", result, "CHF 1’234.56")
+	}
+}
@@ -0,0 +1,122 @@
+package gonumfmt
+
+import "strings"
+
+// numberingSystem описывает цифры числовой системы CLDR и символы,
+// которые CLDR задает для нее вместо символов латинской записи
+type numberingSystem struct {
+	digits  [10]rune
+	symbols *numberSymbols // nil: символы латинской записи локали
+}
+
+// numberSymbols содержит символы числовой системы из блока CLDR
+// symbols-numberSystem-<nu> (numbering_gen.go)
+type numberSymbols struct {
+	Decimal     string
+	Group       string
+	PercentSign string
+	PlusSign    string
+	MinusSign   string
+	Exponential string
+}
+
+// contiguousDigits возвращает цифры системы, идущие подряд начиная с zero
+func contiguousDigits(zero rune) [10]rune {
+	var digits [10]rune
+	for i := range digits {
+		digits[i] = zero + rune(i)
+	}
+	return digits
+}
+
+// numberingSystems содержит числовые (numeric) системы CLDR, кроме latn
+var numberingSystems = map[string]*numberingSystem{
+	"arab":     {digits: contiguousDigits('٠')},
+	"arabext":  {digits: contiguousDigits('۰')},
+	"beng":     {digits: contiguousDigits('০')},
+	"deva":     {digits: contiguousDigits('०')},
+	"fullwide": {digits: contiguousDigits('０')},
+	"gujr":     {digits: contiguousDigits('૦')},
+	"guru":     {digits: contiguousDigits('੦')},
+	"hanidec":  {digits: [10]rune{'〇', '一', '二', '三', '四', '五', '六', '七', '八', '九'}},
+	"khmr":     {digits: contiguousDigits('០')},
+	"knda":     {digits: contiguousDigits('೦')},
+	"laoo":     {digits: contiguousDigits('໐')},
+	"mlym":     {digits: contiguousDigits('൦')},
+	"mymr":     {digits: contiguousDigits('၀')},
+	"orya":     {digits: contiguousDigits('୦')},
+	"tamldec":  {digits: contiguousDigits('௦')},
+	"telu":     {digits: contiguousDigits('౦')},
+	"thai":     {digits: contiguousDigits('๐')},
+	"tibt":     {digits: contiguousDigits('༠')},
+}
+
+func init() {
+	for id, system := range numberingSystems {
+		system.symbols = numberingSymbols[id]
+	}
+}
+
+// resolveNumberingSystem выбирает числовую систему: сначала из опций, затем из
+// расширения "-u-nu-" в теге локали, затем из данных локали. nil означает latn.
+func resolveNumberingSystem(options Options, locale *LocaleData) *numberingSystem {
+	for _, id := range []string{
+		options.NumberingSystem,
+		unicodeExtension(options.Locale, "nu"),
+		locale.NumberingSystem,
+	} {
+		if id == "" {
+			continue
+		}
+		if id == "latn" {
+			return nil
+		}
+		if system, exists := numberingSystems[strings.ToLower(id)]; exists {
+			return system
+		}
+	}
+	return nil
+}
+
+// withSymbols возвращает копию данных локали с символами числовой системы:
+// разделителями, знаками процента, плюса и минуса и разделителем порядка
+func (s *numberingSystem) withSymbols(locale *LocaleData) *LocaleData {
+	if s == nil || s.symbols == nil {
+		return locale
+	}
+
+	data := *locale
+	for _, field := range []struct {
+		dst *string
+		src string
+	}{
+		{&data.DecimalSeparator, s.symbols.Decimal},
+		{&data.GroupSeparator, s.symbols.Group},
+		{&data.PercentSymbol, s.symbols.PercentSign},
+		{&data.PlusSign, s.symbols.PlusSign},
+		{&data.MinusSign, s.symbols.MinusSign},
+		{&data.Exponential, s.symbols.Exponential},
+	} {
+		if field.src != "" {
+			*field.dst = field.src
+		}
+	}
+	return &data
+}
+
+// transliterate заменяет ASCII-цифры в строке цифрами числовой системы
+func (s *numberingSystem) transliterate(str string) string {
+	if s == nil {
+		return str
+	}
+
+	var result strings.Builder
+	result.Grow(len(str) * 3)
+	for _, r := range str {
+		if r >= '0' && r <= '9' {
+			r = s.digits[r-'0']
+		}
+		result.WriteRune(r)
+	}
+	return result.String()
+}
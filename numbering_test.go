@@ -0,0 +1,79 @@
+//go:build !gonumfmt_minimal
+
+package gonumfmt
+
+import "testing"
+
+func TestFormatter_NumberingSystem(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   float64
+		options  []FormatterOption
+		expected string
+	}{
+		{"Latin default", 1234.5, []FormatterOption{WithLocale("en")}, "1,234.5"},
+		{"Explicit latn", 1234.5, []FormatterOption{WithLocale("en"), WithNumberingSystem("latn")}, "1,234.5"},
+		{"Arabic-Indic", 1234.5, []FormatterOption{WithLocale("en"), WithNumberingSystem("arab")}, "١٬٢٣٤٫٥"},
+		{"Extended Arabic-Indic", 1234.5, []FormatterOption{WithLocale("en"), WithNumberingSystem("arabext")}, "۱٬۲۳۴٫۵"},
+		{"Devanagari", 1234.5, []FormatterOption{WithLocale("en"), WithNumberingSystem("deva")}, "१,२३४.५"},
+		{"Thai", 1234.5, []FormatterOption{WithLocale("en"), WithNumberingSystem("thai")}, "๑,๒๓๔.๕"},
+		{"Han decimal", 2024, []FormatterOption{WithLocale("zh"), WithNumberingSystem("hanidec"),
+			WithGrouping(false)}, "二〇二四"},
+		{"Fullwidth", 1234.5, []FormatterOption{WithLocale("ja"), WithNumberingSystem("fullwide")}, "１,２３４.５"},
+		{"Unknown falls back", 1234.5, []FormatterOption{WithLocale("en"), WithNumberingSystem("klingon")}, "1,234.5"},
+		{"Locale extension", 1234.5, []FormatterOption{WithLocale("en-US-u-nu-deva")}, "१,२३४.५"},
+		{"Locale extension with other keys", 1234.5, []FormatterOption{WithLocale("en-u-ca-gregory-nu-thai")}, "๑,๒๓๔.๕"},
+		{"Option overrides extension", 1234.5, []FormatterOption{WithLocale("en-u-nu-deva"),
+			WithNumberingSystem("thai")}, "๑,๒๓๔.๕"},
+		{"Negative", -42, []FormatterOption{WithLocale("en"), WithNumberingSystem("deva")}, "-४२"},
+		{"Currency", 1234.5, []FormatterOption{WithLocale("en"), WithNumberingSystem("deva"),
+			WithCurrency("USD"), WithFixedPrecision(2), WithTrailingZeroRemoval(false)}, "$१,२३४.५०"},
+		{"Percent", 0.25, []FormatterOption{WithLocale("en"), WithNumberingSystem("arab"),
+			WithStyle(Percent)}, "٢٥٪\u061c"},
+		{"Arabic-Indic minus", -42, []FormatterOption{WithLocale("en"), WithNumberingSystem("arab")}, "\u061c-٤٢"},
+		{"Arabic-Indic plus", 42, []FormatterOption{WithLocale("en"), WithNumberingSystem("arab"),
+			WithSignDisplay(SignAlways)}, "\u061c+٤٢"},
+		{"Arabic-Indic scientific", 1230000, []FormatterOption{WithLocale("en"), WithNumberingSystem("arab"),
+			WithStyle(Scientific)}, "١٫٢٣اس٦"},
+		{"Extended Arabic-Indic percent", 0.25, []FormatterOption{WithLocale("fa"), WithStyle(Percent)}, "۲۵٪"},
+		{"Extended Arabic-Indic minus", -42, []FormatterOption{WithLocale("fa")}, "\u200e-\u200e۴۲"},
+		{"Latin symbols of locale", -1234.5, []FormatterOption{WithLocale("de"), WithNumberingSystem("deva")}, "-१.२३४,५"},
+		{"Scientific", 1230000, []FormatterOption{WithLocale("en"), WithNumberingSystem("deva"),
+			WithStyle(Scientific)}, "१.२३E६"},
+		{"Scientific zero", 0, []FormatterOption{WithLocale("en"), WithNumberingSystem("thai"),
+			WithStyle(Scientific)}, "๐E๐"},
+		{"Compact", 1500000, []FormatterOption{WithLocale("en"), WithNumberingSystem("deva"),
+			WithStyle(Compact)}, "१.५M"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewFormatter(tt.options...).Format(tt.number)
+			if result != tt.expected {
+				t.Errorf("Numbering system format(%f) = %s, expected %s",
+					tt.number, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUnicodeExtension(t *testing.T) {
+	tests := []struct {
+		locale   string
+		key      string
+		expected string
+	}{
+		{"ar-EG-u-nu-arab", "nu", "arab"},
+		{"en_US-u-ca-gregory-nu-latn", "nu", "latn"},
+		{"en-u-ca-gregory", "nu", ""},
+		{"en-x-nu-arab", "nu", ""},
+		{"en-u-cu-usd-t-nu-arab", "nu", ""},
+		{"en", "nu", ""},
+	}
+
+	for _, tt := range tests {
+		if result := unicodeExtension(tt.locale, tt.key); result != tt.expected {
+			t.Errorf("unicodeExtension(%q, %q) = %q, expected %q", tt.locale, tt.key, result, tt.expected)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package gonumfmt
 
+import "math"
+
 // Style определяет стиль форматирования
 type Style int
 
@@ -20,6 +22,32 @@ const (
 	CurrencyName
 )
 
+// CurrencyUsage определяет назначение денежной суммы
+type CurrencyUsage int
+
+const (
+	CurrencyUsageStandard CurrencyUsage = iota // безналичные расчеты: minor units и шаг валюты
+	CurrencyUsageCash                          // наличные: CashDigits и CashRounding, CHF 0.05
+)
+
+// CurrencySign определяет запись знака денежной суммы
+type CurrencySign int
+
+const (
+	CurrencySignStandard   CurrencySign = iota // шаблон валюты локали: -$1,234.56
+	CurrencySignAccounting                     // бухгалтерский шаблон локали: ($1,234.56)
+)
+
+// GroupingStrategy определяет, когда разделять цифры целой части на группы
+type GroupingStrategy int
+
+const (
+	GroupingAuto   GroupingStrategy = iota // по MinimumGroupingDigits локали
+	GroupingAlways                         // всегда, начиная с 1 234
+	GroupingMin2                           // только если в старшей группе хотя бы две цифры: 1234, 12 345
+	GroupingOff                            // без группировки
+)
+
 // CompactDisplay определяет тип компактного отображения
 type CompactDisplay int
 
@@ -37,6 +65,17 @@ const (
 	Engineering
 )
 
+// ExponentDisplay определяет запись порядка в научной и инженерной нотации
+type ExponentDisplay int
+
+const (
+	ExponentAuto        ExponentDisplay = iota // по данным локали: степень или символ Exponential
+	ExponentE                                  // 1.23E6
+	ExponentLowerE                             // 1.23e6
+	ExponentSuperscript                        // 1.23×10⁶
+	ExponentCaret                              // 1.23×10^6
+)
+
 // SignDisplay определяет отображение знака
 type SignDisplay int
 
@@ -60,22 +99,44 @@ const (
 	RoundUp
 )
 
+// ParseMode определяет строгость разбора строк. ParseStrict принимает только
+// то, что выводит Format с теми же настройками: группы, ведущие нули, число
+// знаков после запятой и знак проверяются по Options. Единственная вольность -
+// "-0", который Format выводит для отрицательных чисел, округленных до нуля.
+type ParseMode int
+
+const (
+	ParseLenient ParseMode = iota // варианты знаков, пробелов, группировки и цифр
+	ParseStrict                   // только вывод Format
+)
+
 // Options содержит все настройки форматирования
 type Options struct {
 	Locale                string
 	Style                 Style
 	Currency              string
 	CurrencyDisplay       CurrencyDisplay
-	UseGrouping           bool
+	CurrencyUsage         CurrencyUsage
+	CurrencySign          CurrencySign
+	Grouping              GroupingStrategy
 	MinimumIntegerDigits  int
 	MinimumFractionDigits int
 	MaximumFractionDigits int
 	RoundingMode          RoundingMode
+	RoundingIncrement     float64 // шаг округления, например 0.05 или 5; 0 - без шага
 	CompactDisplay        CompactDisplay
 	CompactPrecision      int
 	Notation              Notation
+	ExponentDisplay       ExponentDisplay
+	MinimumExponentDigits int
+	ExponentSignAlways    bool
 	SignDisplay           SignDisplay
+	NumberingSystem       string
 	TrimTrailingZeros     bool
+	ParseMode             ParseMode
+
+	precisionSet bool // точность задана явно, а не по minor units валюты
+	trimSet      bool // удаление нулей задано явно
 }
 
 // FormatterOption функция для настройки форматирования
@@ -84,9 +145,9 @@ type FormatterOption func(*Options)
 // DefaultOptions возвращает настройки по умолчанию
 func DefaultOptions() Options {
 	return Options{
-		Locale:                getSystemLocale(),
+		Locale:                defaultLocale(),
 		Style:                 Decimal,
-		UseGrouping:           true,
+		Grouping:              GroupingAuto,
 		MinimumIntegerDigits:  1,
 		MinimumFractionDigits: 0,
 		MaximumFractionDigits: 3,
@@ -94,8 +155,11 @@ func DefaultOptions() Options {
 		CompactDisplay:        Short,
 		CompactPrecision:      2,
 		Notation:              Standard,
+		ExponentDisplay:       ExponentAuto,
+		MinimumExponentDigits: 1,
 		SignDisplay:           SignAuto,
 		TrimTrailingZeros:     true,
+		ParseMode:             ParseLenient,
 	}
 }
 
@@ -113,7 +177,8 @@ func WithStyle(style Style) FormatterOption {
 	}
 }
 
-// WithCurrency устанавливает валюту
+// WithCurrency устанавливает валюту. Если точность не задана явно, число
+// выводится с количеством знаков после запятой по ISO 4217: "$1.50", "¥1,235".
 func WithCurrency(currency string) FormatterOption {
 	return func(o *Options) {
 		o.Currency = currency
@@ -128,10 +193,39 @@ func WithCurrencyDisplay(display CurrencyDisplay) FormatterOption {
 	}
 }
 
-// WithGrouping включает/выключает группировку цифр
+// WithCurrencyUsage устанавливает назначение суммы: для CurrencyUsageCash
+// точность и шаг округления берутся из CashDigits и CashRounding валюты
+func WithCurrencyUsage(usage CurrencyUsage) FormatterOption {
+	return func(o *Options) {
+		o.CurrencyUsage = usage
+	}
+}
+
+// WithCurrencySign устанавливает запись знака суммы: CurrencySignAccounting
+// выводит отрицательные суммы по бухгалтерскому шаблону локали, в en - в скобках
+func WithCurrencySign(sign CurrencySign) FormatterOption {
+	return func(o *Options) {
+		o.CurrencySign = sign
+	}
+}
+
+// WithGroupingStrategy устанавливает стратегию группировки цифр
+func WithGroupingStrategy(strategy GroupingStrategy) FormatterOption {
+	return func(o *Options) {
+		o.Grouping = strategy
+	}
+}
+
+// WithGrouping включает/выключает группировку цифр.
+//
+// Deprecated: используйте WithGroupingStrategy; true соответствует GroupingAuto, false - GroupingOff.
 func WithGrouping(useGrouping bool) FormatterOption {
 	return func(o *Options) {
-		o.UseGrouping = useGrouping
+		if useGrouping {
+			o.Grouping = GroupingAuto
+		} else {
+			o.Grouping = GroupingOff
+		}
 	}
 }
 
@@ -140,6 +234,7 @@ func WithPrecision(minFraction, maxFraction int) FormatterOption {
 	return func(o *Options) {
 		o.MinimumFractionDigits = minFraction
 		o.MaximumFractionDigits = maxFraction
+		o.precisionSet = true
 	}
 }
 
@@ -148,6 +243,7 @@ func WithFixedPrecision(precision int) FormatterOption {
 	return func(o *Options) {
 		o.MinimumFractionDigits = precision
 		o.MaximumFractionDigits = precision
+		o.precisionSet = true
 	}
 }
 
@@ -165,6 +261,17 @@ func WithRoundingMode(mode RoundingMode) FormatterOption {
 	}
 }
 
+// WithRoundingIncrement устанавливает шаг округления: число округляется до
+// кратного increment (0.05, 0.25, 5) по RoundingMode. Шаг заменяет шаг
+// валюты; точность вывода задается отдельно. Шаг действует в обычной записи
+// стилей Decimal и Currency, но не в процентах, компактной записи и научной
+// нотации.
+func WithRoundingIncrement(increment float64) FormatterOption {
+	return func(o *Options) {
+		o.RoundingIncrement = increment
+	}
+}
+
 // WithCompactDisplay устанавливает компактное отображение
 func WithCompactDisplay(display CompactDisplay) FormatterOption {
 	return func(o *Options) {
@@ -187,6 +294,27 @@ func WithNotation(notation Notation) FormatterOption {
 	}
 }
 
+// WithExponentDisplay устанавливает запись порядка вместо заданной локалью
+func WithExponentDisplay(display ExponentDisplay) FormatterOption {
+	return func(o *Options) {
+		o.ExponentDisplay = display
+	}
+}
+
+// WithExponentDigits устанавливает минимальное количество цифр порядка (1.23E06)
+func WithExponentDigits(digits int) FormatterOption {
+	return func(o *Options) {
+		o.MinimumExponentDigits = digits
+	}
+}
+
+// WithExponentSign включает/выключает знак плюс у положительного порядка (1.23E+6)
+func WithExponentSign(always bool) FormatterOption {
+	return func(o *Options) {
+		o.ExponentSignAlways = always
+	}
+}
+
 // WithSignDisplay устанавливает отображение знака
 func WithSignDisplay(signDisplay SignDisplay) FormatterOption {
 	return func(o *Options) {
@@ -194,9 +322,52 @@ func WithSignDisplay(signDisplay SignDisplay) FormatterOption {
 	}
 }
 
+// WithNumberingSystem устанавливает числовую систему CLDR ("arab", "deva",
+// "thai", "hanidec", "fullwide" и т.д.) вместо заданной локалью или расширением "-u-nu-"
+func WithNumberingSystem(system string) FormatterOption {
+	return func(o *Options) {
+		o.NumberingSystem = system
+	}
+}
+
 // WithTrailingZeroRemoval включает/выключает удаление нулей
 func WithTrailingZeroRemoval(trim bool) FormatterOption {
 	return func(o *Options) {
 		o.TrimTrailingZeros = trim
+		o.trimSet = true
+	}
+}
+
+// WithParseMode устанавливает строгость разбора строк
+func WithParseMode(mode ParseMode) FormatterOption {
+	return func(o *Options) {
+		o.ParseMode = mode
+	}
+}
+
+// applyCurrencyDigits задает для валюты точность по ISO 4217 (JPY 0, USD 2,
+// KWD 3), если она не указана явно. Нули minor units не удаляются, пока
+// удаление не включено явно. Шаг округления валюты (CHF 0.05 для наличных)
+// действует, если не задан WithRoundingIncrement.
+func (o *Options) applyCurrencyDigits() {
+	if o.Style != Currency || o.Currency == "" {
+		return
+	}
+
+	currency := getCurrencyData(o.Currency)
+	digits, rounding := currency.Digits, currency.Rounding
+	if o.CurrencyUsage == CurrencyUsageCash {
+		digits, rounding = currency.CashDigits, currency.CashRounding
+	}
+	if o.RoundingIncrement == 0 && rounding > 1 {
+		o.RoundingIncrement = float64(rounding) / math.Pow10(digits)
+	}
+
+	if o.precisionSet {
+		return
+	}
+	o.MinimumFractionDigits, o.MaximumFractionDigits = digits, digits
+	if !o.trimSet {
+		o.TrimTrailingZeros = false
 	}
 }
@@ -0,0 +1,926 @@
+package gonumfmt
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Ошибки разбора, которые оборачиваются в *ParseError
+var (
+	ErrEmptyInput                = errors.New("empty input")
+	ErrUnexpectedCharacter       = errors.New("unexpected character")
+	ErrNoDigits                  = errors.New("no digits")
+	ErrMisplacedGroupSeparator   = errors.New("misplaced group separator")
+	ErrMissingGroupSeparator     = errors.New("missing group separator")
+	ErrDuplicateDecimalSeparator = errors.New("duplicate decimal separator")
+	ErrIntegerDigits             = errors.New("unexpected number of integer digits")
+	ErrFractionDigits            = errors.New("unexpected number of fraction digits")
+	ErrSign                      = errors.New("unexpected sign")
+	ErrOutOfRange                = errors.New("value out of range")
+	ErrNoCurrency                = errors.New("no currency")
+	ErrAmbiguousCurrency         = errors.New("ambiguous currency")
+)
+
+// ParseError описывает ошибку разбора строки и место, где она обнаружена
+type ParseError struct {
+	Input  string // исходная строка
+	Offset int    // смещение в байтах от начала Input
+	Err    error  // причина, одна из ошибок Err*
+}
+
+// Error реализует интерфейс error
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("gonumfmt: parsing %q: %v at offset %d", e.Input, e.Err, e.Offset)
+}
+
+// Unwrap возвращает причину ошибки для errors.Is
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Normalization описывает исправления, которые сделал нестрогий разбор
+type Normalization int
+
+const (
+	NormalizedWhitespace     Normalization = 1 << iota // пробелы в начале или конце строки
+	NormalizedSign                                     // дефис или U+2212 вместо знака локали
+	NormalizedGroupSeparator                           // пробел, NBSP или апостроф вместо разделителя групп
+	NormalizedGrouping                                 // группировка пропущена или нарушена
+	NormalizedAffix                                    // символ валюты, процентов или сокращения стоит не по шаблону
+	NormalizedDigits                                   // цифры другой числовой системы, например ASCII вместо ٠-٩
+)
+
+// normalizationNames содержит имена флагов Normalization в порядке битов
+var normalizationNames = []string{"whitespace", "sign", "group separator", "grouping", "affix", "digits"}
+
+// Has сообщает, установлен ли флаг
+func (n Normalization) Has(flag Normalization) bool {
+	return n&flag != 0
+}
+
+// String перечисляет установленные флаги через запятую
+func (n Normalization) String() string {
+	var names []string
+	for i, name := range normalizationNames {
+		if n.Has(1 << i) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
+// ParseResult содержит разобранное значение и сведения о нормализации ввода
+type ParseResult struct {
+	Value      float64
+	Currency   string        // ISO-код валюты, только для ParseCurrencyDetailed
+	Normalized Normalization // что пришлось исправить в режиме ParseLenient
+}
+
+// Варианты знаков и разделителей групп, которые принимает ParseLenient
+var (
+	lenientMinusSigns      = []string{"-", "\u2212", "\u2012", "\u2013", "\ufe63", "\uff0d"}
+	lenientPlusSigns       = []string{"+", "\ufb29", "\ufe62", "\uff0b"}
+	lenientGroupSeparators = []string{" ", "\u00a0", "\u202f", "'", "\u2019"}
+)
+
+// currencySpaces содержит пробелы, которые встречаются между суммой и валютой
+var currencySpaces = []string{" ", "\u00a0", "\u202f"}
+
+// parser хранит состояние разбора одной строки
+type parser struct {
+	f          *Formatter
+	input      string
+	strict     bool
+	normalized Normalization
+	currency   bool // знак суммы проверяет шаблон валюты
+	plus       bool // перед числом стоял плюс
+}
+
+// newParser создает парсер для строки с режимом разбора форматтера
+func (f *Formatter) newParser(s string) *parser {
+	return &parser{
+		f:      f,
+		input:  s,
+		strict: f.options.ParseMode == ParseStrict,
+	}
+}
+
+// errorAt создает ошибку разбора в указанной позиции
+func (p *parser) errorAt(offset int, err error) *ParseError {
+	return &ParseError{Input: p.input, Offset: offset, Err: err}
+}
+
+// Parse разбирает строку, отформатированную по правилам локали, обратно в число
+func (f *Formatter) Parse(s string) (float64, error) {
+	result, err := f.ParseDetailed(s)
+	return result.Value, err
+}
+
+// ParseDetailed разбирает число так же, как Parse, и сообщает,
+// какие отклонения от формата локали были нормализованы
+func (f *Formatter) ParseDetailed(s string) (ParseResult, error) {
+	p := f.newParser(s)
+
+	start, end, err := p.trim()
+	if err != nil {
+		return ParseResult{}, err
+	}
+
+	value, err := p.parseNumber(start, end)
+	if err != nil {
+		return ParseResult{}, err
+	}
+	return ParseResult{Value: value, Normalized: p.normalized}, nil
+}
+
+// trim возвращает границы строки без окружающих пробелов (только в нестрогом режиме)
+func (p *parser) trim() (start, end int, err error) {
+	start, end = 0, len(p.input)
+	if !p.strict {
+		trimmed := strings.TrimLeftFunc(p.input, unicode.IsSpace)
+		start = len(p.input) - len(trimmed)
+		end = start + len(strings.TrimRightFunc(trimmed, unicode.IsSpace))
+		if start != 0 || end != len(p.input) {
+			p.normalized |= NormalizedWhitespace
+		}
+	}
+
+	if start == end {
+		return 0, 0, p.errorAt(0, ErrEmptyInput)
+	}
+	return start, end, nil
+}
+
+// parseNumber разбирает число со знаком в input[start:end]; позиции ошибок считаются от начала input
+func (p *parser) parseNumber(start, end int) (float64, error) {
+	// Специальные значения в том виде, в котором их выводит Format
+	switch p.input[start:end] {
+	case p.f.nan():
+		return math.NaN(), nil
+	case p.f.infinity():
+		return math.Inf(1), nil
+	case "-" + p.f.infinity():
+		return math.Inf(-1), nil
+	}
+
+	digits, exponent, negative, err := p.parseScaled(start, end)
+	if err != nil {
+		return 0, err
+	}
+
+	// Порядок добавляется к строке, чтобы strconv округлил результат один раз
+	value, err := strconv.ParseFloat(digits+"e"+strconv.Itoa(exponent), 64)
+	if err != nil {
+		return 0, p.errorAt(start, ErrOutOfRange)
+	}
+
+	if negative {
+		value = -value
+	}
+	return value, nil
+}
+
+// parseScaled разбирает число с учетом процентной и компактной записи
+// и возвращает его цифры в ASCII-виде вместе с десятичным порядком
+func (p *parser) parseScaled(start, end int) (digits string, exponent int, negative bool, err error) {
+	style := p.f.options.Style
+
+	// Шаблон процентов оборачивает число вместе со знаком.
+	// Строгий разбор принимает его только для стиля Percent.
+	percent := false
+	if !p.strict || style == Percent {
+		prefix, suffix := percentAffixes(p.f.locale)
+		start, end, percent = p.cutAffixes(start, end, prefix, suffix)
+	}
+	if percent {
+		exponent = -2
+	} else if p.strict && style == Percent {
+		return "", 0, false, p.errorAt(end, ErrUnexpectedCharacter)
+	}
+
+	signStart, signEnd := start, end
+	negative, start, end = p.parseSign(start, end)
+	p.plus = !negative && (start != signStart || end != signEnd)
+
+	// Компактный шаблон, наоборот, находится внутри знака
+	if !percent && (!p.strict || style == Compact) {
+		var compact bool
+		var compactExponent int
+		start, end, compactExponent, compact = p.cutCompactPattern(start, end)
+		if compact {
+			exponent = compactExponent
+		}
+	}
+
+	digits, err = p.parseDecimalBody(start, end)
+	if err == nil && p.strict && !p.currency {
+		err = p.checkSign(signStart, negative, p.plus, strings.Trim(digits, "0.") == "")
+	}
+	return digits, exponent, negative, err
+}
+
+// checkSign проверяет в строгом режиме, что знак стоит так, как его ставит
+// Format при заданном SignDisplay. Минус у нуля допустим для SignAuto и
+// SignAlways: Format выводит "-0" для отрицательных чисел, округленных до нуля.
+func (p *parser) checkSign(offset int, negative, plus, zero bool) error {
+	var ok bool
+	switch p.f.options.SignDisplay {
+	case SignNever:
+		ok = !negative && !plus
+	case SignAlways:
+		ok = negative || plus
+	case SignExceptZero:
+		ok = (negative || plus) != zero
+	default:
+		ok = !plus
+	}
+	if !ok {
+		return p.errorAt(offset, ErrSign)
+	}
+	return nil
+}
+
+// parseSign определяет знак по шаблонам локали и возвращает границы числа без знака
+func (p *parser) parseSign(start, end int) (negative bool, bodyStart, bodyEnd int) {
+	locale := p.f.locale
+
+	minusSigns := []string{locale.MinusSign}
+	plusSigns := []string{locale.PlusSign}
+	if !p.strict {
+		minusSigns = append(minusSigns, lenientMinusSigns...)
+		plusSigns = append(plusSigns, lenientPlusSigns...)
+	}
+
+	// Шаблоны могут содержать знак как {sign} или как готовый символ локали
+	negativePrefix, negativeSuffix := signAffixes(locale.NegativePattern, locale.MinusSign)
+	for _, sign := range minusSigns {
+		prefix := strings.ReplaceAll(negativePrefix, locale.MinusSign, sign)
+		suffix := strings.ReplaceAll(negativeSuffix, locale.MinusSign, sign)
+		if bodyStart, bodyEnd, ok := p.cutAffixes(start, end, prefix, suffix); ok {
+			if sign != locale.MinusSign {
+				p.normalized |= NormalizedSign
+			}
+			return true, bodyStart, bodyEnd
+		}
+	}
+
+	positivePrefix, positiveSuffix := signAffixes(locale.PositivePattern, locale.PlusSign)
+	for _, sign := range plusSigns {
+		prefix := strings.ReplaceAll(positivePrefix, locale.PlusSign, sign)
+		suffix := strings.ReplaceAll(positiveSuffix, locale.PlusSign, sign)
+		bodyStart, bodyEnd, ok := p.cutAffixes(start, end, prefix, suffix)
+		// Шаблон положительного числа может не содержать знака, а SignAlways ставит его впереди
+		if !ok && sign != "" && strings.HasPrefix(p.input[start:end], sign) {
+			bodyStart, bodyEnd, ok = start+len(sign), end, true
+		}
+		if ok {
+			if sign != locale.PlusSign {
+				p.normalized |= NormalizedSign
+			}
+			return false, bodyStart, bodyEnd
+		}
+	}
+
+	return false, start, end
+}
+
+// cutCompactPattern ищет компактный шаблон локали (Short или Long) вокруг числа
+// и возвращает границы числа внутри него и соответствующий десятичный порядок
+func (p *parser) cutCompactPattern(start, end int) (bodyStart, bodyEnd, exponent int, ok bool) {
+	bodyStart, bodyEnd = start, end
+
+	// Из нескольких подходящих шаблонов выбираем совпавший с самым длинным
+	// текстом, а при равной длине - совпавший без нормализации. Нестрогий
+	// разбор сравнивает шаблоны без пробелов, поэтому считается именно
+	// совпавший текст: "1,5mM" в ca - это "0mM", а не "0 M". Диапазоны
+	// перебираются по порядку, чтобы результат не зависел от обхода map.
+	longest, bestExact := -1, false
+	normalized := p.normalized
+	for _, rangeType := range slices.Sorted(maps.Keys(p.f.locale.CompactPatterns)) {
+		patternData := p.f.locale.CompactPatterns[rangeType]
+		for _, pattern := range []string{patternData.Short, patternData.Long} {
+			prefix, suffix, found := strings.Cut(pattern, "0")
+			if !found {
+				continue
+			}
+
+			saved := p.normalized
+			if s, e, matched := p.cutAffixes(start, end, prefix, suffix); matched {
+				length := (s - start) + (end - e)
+				exact := p.normalized == saved
+				if length > longest || length == longest && exact && !bestExact {
+					bodyStart, bodyEnd = s, e
+					exponent = compactExponent(rangeType)
+					longest, bestExact = length, exact
+					normalized = p.normalized
+					ok = true
+				}
+			}
+			p.normalized = saved
+		}
+	}
+
+	p.normalized = normalized
+	return bodyStart, bodyEnd, exponent, ok
+}
+
+// cutAffixes отделяет от input[start:end] текст шаблона до и после числа.
+// Нестрогий разбор допускает любые пробелы между шаблоном и числом.
+func (p *parser) cutAffixes(start, end int, prefix, suffix string) (bodyStart, bodyEnd int, ok bool) {
+	s := p.input[start:end]
+	if p.strict {
+		if matchAffixes(s, prefix, suffix) {
+			return start + len(prefix), end - len(suffix), true
+		}
+		return start, end, false
+	}
+
+	trimmedPrefix := strings.TrimRightFunc(prefix, unicode.IsSpace)
+	trimmedSuffix := strings.TrimLeftFunc(suffix, unicode.IsSpace)
+	if !matchAffixes(s, trimmedPrefix, trimmedSuffix) {
+		return start, end, false
+	}
+
+	body := s[len(trimmedPrefix) : len(s)-len(trimmedSuffix)]
+	trimmedBody := strings.TrimFunc(body, unicode.IsSpace)
+	if trimmedBody == "" {
+		return start, end, false
+	}
+
+	// Пробелы между шаблоном и числом должны совпадать с шаблоном
+	if matchAffixes(s, prefix, suffix) && len(prefix)+len(trimmedBody)+len(suffix) == len(s) {
+		return start + len(prefix), end - len(suffix), true
+	}
+
+	bodyStart = start + len(trimmedPrefix) + strings.Index(body, trimmedBody)
+	p.normalized |= NormalizedAffix
+	return bodyStart, bodyStart + len(trimmedBody), true
+}
+
+// compactExponent возвращает десятичный порядок диапазона компактной записи
+func compactExponent(rangeType CompactRange) int {
+	return compactExponents[rangeType]
+}
+
+// signAffixes возвращает текст шаблона знака до и после {number}
+func signAffixes(pattern, sign string) (prefix, suffix string) {
+	pattern = strings.ReplaceAll(pattern, "{sign}", sign)
+	prefix, suffix, found := strings.Cut(pattern, "{number}")
+	if !found {
+		return "", ""
+	}
+	return prefix, suffix
+}
+
+// percentAffixes возвращает текст шаблона процентов до и после {number}
+func percentAffixes(locale *LocaleData) (prefix, suffix string) {
+	pattern := strings.ReplaceAll(locale.PercentPattern, "{symbol}", locale.PercentSymbol)
+	prefix, suffix, found := strings.Cut(pattern, "{number}")
+	if !found {
+		return "", ""
+	}
+	return prefix, suffix
+}
+
+// matchAffixes проверяет, что s начинается с prefix, заканчивается suffix и между ними что-то есть
+func matchAffixes(s, prefix, suffix string) bool {
+	return (prefix != "" || suffix != "") && len(s) > len(prefix)+len(suffix) &&
+		strings.HasPrefix(s, prefix) && strings.HasSuffix(s, suffix)
+}
+
+// parseDecimalBody проверяет целую и дробную части input[start:end]
+// и возвращает число в ASCII-виде, пригодном для strconv
+func (p *parser) parseDecimalBody(start, end int) (string, error) {
+	var result strings.Builder
+	decimalSep := p.f.locale.DecimalSeparator
+	groupSep := p.f.locale.GroupSeparator
+
+	groupSeparators := []string{groupSep}
+	if !p.strict {
+		groupSeparators = append(groupSeparators, lenientGroupSeparators...)
+	}
+
+	digits := 0
+	digitsSinceGroup := 0
+	var intPositions []int  // позиции цифр целой части
+	var fracPositions []int // позиции цифр дробной части
+	var groups []int        // длины групп целой части слева направо
+	var separators []int    // позиции разделителей групп
+	decimalAt := -1         // позиция десятичного разделителя
+	seenDecimal := false
+
+	for i := start; i < end; {
+		rest := p.input[i:end]
+
+		if value, size, ok := p.digit(rest); ok {
+			result.WriteByte(byte('0' + value))
+			digits++
+			if seenDecimal {
+				fracPositions = append(fracPositions, i)
+			} else {
+				intPositions = append(intPositions, i)
+				digitsSinceGroup++
+			}
+			i += size
+			continue
+		}
+
+		if decimalSep != "" && strings.HasPrefix(rest, decimalSep) {
+			if seenDecimal {
+				return "", p.errorAt(i, ErrDuplicateDecimalSeparator)
+			}
+			result.WriteByte('.')
+			seenDecimal = true
+			decimalAt = i
+			i += len(decimalSep)
+			continue
+		}
+
+		separator := ""
+		for _, sep := range groupSeparators {
+			if sep != "" && strings.HasPrefix(rest, sep) {
+				separator = sep
+				break
+			}
+		}
+		if separator == "" || (seenDecimal && separator != groupSep) {
+			return "", p.errorAt(i, ErrUnexpectedCharacter)
+		}
+
+		// Разделитель групп допустим только в целой части после цифр
+		if seenDecimal || len(intPositions) == 0 || (p.strict && p.f.options.Grouping == GroupingOff) {
+			return "", p.errorAt(i, ErrMisplacedGroupSeparator)
+		}
+		if separator != groupSep {
+			p.normalized |= NormalizedGroupSeparator
+		}
+		groups = append(groups, digitsSinceGroup)
+		separators = append(separators, i)
+		digitsSinceGroup = 0
+		i += len(separator)
+	}
+
+	if digits == 0 {
+		return "", p.errorAt(start, ErrNoDigits)
+	}
+	if p.strict {
+		if err := p.checkDigits(result.String(), start, end, decimalAt, intPositions, fracPositions); err != nil {
+			return "", err
+		}
+	}
+
+	misplaced := false
+	expected := p.f.groupSizes(len(intPositions))
+	if len(separators) > 0 {
+		groups = append(groups, digitsSinceGroup)
+		offset, ok := p.f.checkGroups(groups, separators)
+		if ok && len(expected) == 1 {
+			// Format не группирует такое короткое число (MinimumGroupingDigits)
+			offset, ok = separators[0], false
+		}
+		if !ok {
+			if p.strict {
+				return "", p.errorAt(offset, ErrMisplacedGroupSeparator)
+			}
+			misplaced = true
+		}
+	} else if len(expected) > 1 {
+		// Format группирует все числа длиннее одной группы
+		if p.strict {
+			return "", p.errorAt(intPositions[expected[0]], ErrMissingGroupSeparator)
+		}
+		misplaced = true
+	}
+
+	if misplaced {
+		p.normalized |= NormalizedGrouping
+	}
+	return result.String(), nil
+}
+
+// checkDigits проверяет в строгом режиме, что целая и дробная части
+// записаны так, как их выводит Format: без лишних ведущих нулей, без
+// разделителя по краям и с числом знаков после запятой по настройкам точности
+func (p *parser) checkDigits(number string, start, end, decimalAt int, intPositions, fracPositions []int) error {
+	options := p.f.options
+	intPart, fracPart, _ := strings.Cut(number, ".")
+
+	minInt := max(options.MinimumIntegerDigits, 1)
+	switch {
+	case len(intPart) < minInt:
+		return p.errorAt(start, ErrIntegerDigits)
+	case len(intPart) > minInt && intPart[0] == '0':
+		return p.errorAt(intPositions[0], ErrIntegerDigits)
+	case decimalAt >= 0 && fracPart == "":
+		return p.errorAt(decimalAt, ErrFractionDigits)
+	case len(fracPart) > options.MaximumFractionDigits:
+		return p.errorAt(fracPositions[options.MaximumFractionDigits], ErrFractionDigits)
+	}
+
+	if options.TrimTrailingZeros {
+		// Format отбрасывает все нули в конце дробной части
+		if significant := len(strings.TrimRight(fracPart, "0")); significant < len(fracPart) {
+			return p.errorAt(fracPositions[significant], ErrFractionDigits)
+		}
+	} else if len(fracPart) < options.MinimumFractionDigits {
+		return p.errorAt(end, ErrFractionDigits)
+	}
+	return nil
+}
+
+// digit разбирает цифру в начале s и возвращает ее значение и длину в байтах.
+// Строгий разбор принимает только цифры числовой системы форматтера,
+// нестрогий - любые десятичные цифры Unicode.
+func (p *parser) digit(s string) (value, size int, ok bool) {
+	r, size := utf8.DecodeRuneInString(s)
+	if p.f.numbering == nil {
+		if r >= '0' && r <= '9' {
+			return int(r - '0'), size, true
+		}
+	} else if value := slices.Index(p.f.numbering.digits[:], r); value >= 0 {
+		return value, size, true
+	}
+
+	if p.strict {
+		return 0, 0, false
+	}
+	if value, ok := digitValue(r); ok {
+		p.normalized |= NormalizedDigits
+		return value, size, true
+	}
+	return 0, 0, false
+}
+
+// digitValue возвращает значение десятичной цифры Unicode (категория Nd).
+// Цифры Nd идут блоками по десять подряд, начиная с нуля.
+func digitValue(r rune) (int, bool) {
+	for _, rng := range unicode.Nd.R16 {
+		if r >= rune(rng.Lo) && r <= rune(rng.Hi) {
+			return int(r-rune(rng.Lo)) % 10, true
+		}
+	}
+	for _, rng := range unicode.Nd.R32 {
+		if r >= rune(rng.Lo) && r <= rune(rng.Hi) {
+			return int(r-rune(rng.Lo)) % 10, true
+		}
+	}
+	return 0, false
+}
+
+// checkGroups проверяет длины групп целой части по размерам групп локали.
+// При ошибке возвращает позицию разделителя, стоящего не на своем месте.
+func (f *Formatter) checkGroups(groups, separators []int) (int, bool) {
+	primary, secondary := f.groupingSizes()
+	last := len(groups) - 1
+
+	// Первая группа может быть короче, но не длиннее следующих за ней
+	first := secondary
+	if last == 1 {
+		first = primary
+	}
+	if groups[0] < 1 || groups[0] > first {
+		return separators[0], false
+	}
+	for i := 1; i < last; i++ {
+		if groups[i] != secondary {
+			return separators[i], false
+		}
+	}
+	if groups[last] != primary {
+		return separators[last-1], false
+	}
+	return 0, true
+}
+
+// currencyMatch описывает найденное в строке обозначение валюты
+type currencyMatch struct {
+	code   string
+	token  string // совпавший символ, код или название
+	offset int    // позиция token в строке
+	start  int    // начало числа
+	end    int    // конец числа
+	exact  bool   // строка совпала с шаблоном валюты форматтера
+
+	negative    bool // шаблон отрицательной суммы: "-$5", "($5)"
+	plus        bool // шаблон суммы с плюсом: "+$5"
+	lenientSign bool // знак шаблона заменен вариантом из ParseLenient
+}
+
+// currencyTemplate описывает шаблон суммы с подставленным знаком
+type currencyTemplate struct {
+	format      string
+	exact       bool // шаблон записи знака форматтера (CurrencySign)
+	negative    bool
+	plus        bool
+	lenientSign bool
+}
+
+// ParseCurrency разбирает денежную сумму и возвращает число и ISO-код валюты.
+// Неоднозначный символ (например, ¥ для JPY и CNY) разрешается по валюте
+// форматтера и валюте локали по умолчанию, иначе возвращается ErrAmbiguousCurrency.
+func (f *Formatter) ParseCurrency(s string) (float64, string, error) {
+	result, err := f.ParseCurrencyDetailed(s)
+	return result.Value, result.Currency, err
+}
+
+// ParseCurrencyDetailed разбирает денежную сумму так же, как ParseCurrency,
+// и сообщает, какие отклонения от формата локали были нормализованы
+func (f *Formatter) ParseCurrencyDetailed(s string) (ParseResult, error) {
+	p := f.newParser(s)
+
+	start, end, err := p.trim()
+	if err != nil {
+		return ParseResult{}, err
+	}
+
+	matches := p.matchCurrency(start, end)
+	if len(matches) == 0 {
+		value, err := p.parseNumber(start, end)
+		if err != nil {
+			return ParseResult{}, err
+		}
+		// Сумма без обозначения валюты относится к валюте форматтера
+		if f.options.Currency == "" {
+			return ParseResult{}, p.errorAt(start, ErrNoCurrency)
+		}
+		return ParseResult{Value: value, Currency: f.options.Currency, Normalized: p.normalized}, nil
+	}
+
+	match, err := f.resolveCurrency(matches)
+	if err != nil {
+		return ParseResult{}, p.errorAt(matches[0].offset, err)
+	}
+	if !match.exact {
+		p.normalized |= NormalizedAffix
+	}
+	if match.lenientSign {
+		p.normalized |= NormalizedSign
+	}
+
+	p.currency = true
+	value, err := p.parseNumber(match.start, match.end)
+	if err != nil {
+		return ParseResult{}, err
+	}
+	if p.strict {
+		// Знак стоит либо в шаблоне валюты, либо перед самим числом
+		negative, plus := match.negative || math.Signbit(value), match.plus || p.plus
+		if err := p.checkSign(start, negative, plus, value == 0); err != nil {
+			return ParseResult{}, err
+		}
+	}
+	if match.negative {
+		value = -value
+	}
+	return ParseResult{Value: value, Currency: match.code, Normalized: p.normalized}, nil
+}
+
+// matchCurrency находит лучшие совпадения обозначений валют в начале или конце input[start:end].
+// Совпадения с шаблоном валюты предпочтительнее, затем более длинные обозначения.
+// Строгий разбор принимает только совпадения с шаблоном записи знака форматтера.
+func (p *parser) matchCurrency(start, end int) []currencyMatch {
+	s := p.input[start:end]
+
+	var best []currencyMatch
+	for _, code := range p.f.currencyCodes() {
+		data := p.f.currencyFormat(code)
+		templates := p.currencyTemplates(data)
+		tokens := []string{data.Symbol, code, data.Name}
+		// Нестрогий разбор принимает и общий символ валюты: "¥" для JPY в ja,
+		// где CLDR использует "￥"
+		alternate := ""
+		if global, exists := currencyData[code]; exists && !p.strict && global.Symbol != data.Symbol {
+			alternate = global.Symbol
+			tokens = append(tokens, alternate)
+		}
+		for _, token := range tokens {
+			if token == "" {
+				continue
+			}
+
+			var match currencyMatch
+			ok := false
+			for _, template := range templates {
+				if match, ok = matchCurrencyTemplate(s, template.format, token, code); ok {
+					match.exact = template.exact
+					match.negative = template.negative
+					match.plus = template.plus
+					match.lenientSign = template.lenientSign
+					break
+				}
+			}
+			if !ok && !p.strict {
+				match, ok = matchCurrencyToken(s, token, code)
+			}
+			if !ok {
+				continue
+			}
+			if token == alternate {
+				match.exact = false
+			}
+
+			switch {
+			case len(best) == 0 || betterCurrencyMatch(match, best[0]):
+				best = []currencyMatch{match}
+			case !betterCurrencyMatch(best[0], match) && best[0].token == match.token:
+				best = append(best, match)
+			}
+		}
+	}
+
+	// Один код может совпасть несколькими способами, оставляем уникальные
+	unique := best[:0]
+	for _, match := range best {
+		if len(unique) == 0 || unique[len(unique)-1].code != match.code {
+			match.offset += start
+			match.start += start
+			match.end += start
+			unique = append(unique, match)
+		}
+	}
+	return unique
+}
+
+// currencyTemplates возвращает шаблоны суммы в валюте со всеми знаками:
+// сначала шаблоны записи знака форматтера, при нестрогом разборе также
+// шаблоны другой записи, например скобки бухгалтерского формата
+func (p *parser) currencyTemplates(currency *CurrencyData) []currencyTemplate {
+	locale := p.f.locale
+
+	minusSigns := []string{locale.MinusSign}
+	plusSigns := []string{locale.PlusSign}
+	styles := []CurrencySign{p.f.options.CurrencySign}
+	if !p.strict {
+		minusSigns = append(minusSigns, lenientMinusSigns...)
+		plusSigns = append(plusSigns, lenientPlusSigns...)
+		for _, style := range []CurrencySign{CurrencySignStandard, CurrencySignAccounting} {
+			if style != p.f.options.CurrencySign {
+				styles = append(styles, style)
+			}
+		}
+	}
+
+	var templates []currencyTemplate
+	for _, style := range styles {
+		exact := style == p.f.options.CurrencySign
+		positive, negative := p.f.currencyPatterns(currency, style)
+		templates = append(templates, currencyTemplate{format: positive, exact: exact})
+
+		// Минус подставляется в отрицательный шаблон, если в нем есть знак,
+		// иначе это скобки; плюс в таком случае ставится перед суммой
+		plus := negative
+		if strings.Contains(negative, "{sign}") {
+			for _, minus := range minusSigns {
+				templates = append(templates, currencyTemplate{
+					format:      strings.ReplaceAll(negative, "{sign}", minus),
+					exact:       exact,
+					negative:    true,
+					lenientSign: minus != locale.MinusSign,
+				})
+			}
+		} else {
+			templates = append(templates, currencyTemplate{format: negative, exact: exact, negative: true})
+			plus = "{sign}" + positive
+		}
+		for _, sign := range plusSigns {
+			templates = append(templates, currencyTemplate{
+				format:      strings.ReplaceAll(plus, "{sign}", sign),
+				exact:       exact,
+				plus:        true,
+				lenientSign: sign != locale.PlusSign,
+			})
+		}
+	}
+	return templates
+}
+
+// betterCurrencyMatch сообщает, что совпадение a лучше совпадения b
+func betterCurrencyMatch(a, b currencyMatch) bool {
+	if a.exact != b.exact {
+		return a.exact
+	}
+	return len(a.token) > len(b.token)
+}
+
+// matchCurrencyTemplate проверяет строку на точное соответствие шаблону валюты
+func matchCurrencyTemplate(s, format, token, code string) (currencyMatch, bool) {
+	format = strings.ReplaceAll(format, "{symbol}", token)
+	format = strings.ReplaceAll(format, "{code}", code)
+
+	prefix, suffix, found := strings.Cut(format, "{number}")
+	if !found || !matchAffixes(s, prefix, suffix) {
+		return currencyMatch{}, false
+	}
+
+	offset := strings.Index(prefix, token)
+	if offset < 0 {
+		offset = len(s) - len(suffix) + max(strings.Index(suffix, token), 0)
+	}
+
+	// По правилам CLDR (currencySpacing) обозначение, примыкающее к числу,
+	// может отделяться от цифр пробелом: "CHF 12.50" при шаблоне "{symbol}{number}"
+	start, end := len(prefix), len(s)-len(suffix)
+	for _, space := range currencySpaces {
+		if strings.HasSuffix(prefix, token) && strings.HasPrefix(s[start:end], space) {
+			start += len(space)
+			break
+		}
+		if strings.HasPrefix(suffix, token) && strings.HasSuffix(s[start:end], space) {
+			end -= len(space)
+			break
+		}
+	}
+
+	return currencyMatch{
+		code:   code,
+		token:  token,
+		offset: offset,
+		start:  start,
+		end:    end,
+		exact:  true,
+	}, true
+}
+
+// matchCurrencyToken ищет обозначение валюты в начале или конце строки
+// без учета шаблона, например "CHF 12.50" при шаблоне "{number} {symbol}"
+func matchCurrencyToken(s, token, code string) (currencyMatch, bool) {
+	if len(s) <= len(token) {
+		return currencyMatch{}, false
+	}
+
+	if strings.HasPrefix(s, token) {
+		start := len(token)
+		for _, space := range currencySpaces {
+			if strings.HasPrefix(s[start:], space) {
+				start += len(space)
+				break
+			}
+		}
+		return currencyMatch{code: code, token: token, start: start, end: len(s)}, true
+	}
+
+	if strings.HasSuffix(s, token) {
+		offset := len(s) - len(token)
+		end := offset
+		for _, space := range currencySpaces {
+			if strings.HasSuffix(s[:end], space) {
+				end -= len(space)
+				break
+			}
+		}
+		return currencyMatch{code: code, token: token, offset: offset, end: end}, true
+	}
+
+	return currencyMatch{}, false
+}
+
+// resolveCurrency выбирает валюту среди равноценных совпадений
+func (f *Formatter) resolveCurrency(matches []currencyMatch) (currencyMatch, error) {
+	if len(matches) == 1 {
+		return matches[0], nil
+	}
+
+	for _, preferred := range []string{f.options.Currency, f.locale.DefaultCurrency} {
+		for _, match := range matches {
+			if preferred != "" && match.code == preferred {
+				return match, nil
+			}
+		}
+	}
+
+	codes := make([]string, len(matches))
+	for i, match := range matches {
+		codes[i] = match.code
+	}
+	return currencyMatch{}, fmt.Errorf("%w: %q may be %s",
+		ErrAmbiguousCurrency, matches[0].token, strings.Join(codes, ", "))
+}
+
+// currencyCodes возвращает отсортированный список валют, известных форматтеру
+func (f *Formatter) currencyCodes() []string {
+	codes := make([]string, 0, len(currencyData)+len(f.locale.CurrencyFormats)+1)
+	for code := range currencyData {
+		codes = append(codes, code)
+	}
+	for code := range f.locale.CurrencyFormats {
+		if _, exists := currencyData[code]; !exists {
+			codes = append(codes, code)
+		}
+	}
+	if f.options.Currency != "" && !slices.Contains(codes, f.options.Currency) {
+		codes = append(codes, f.options.Currency)
+	}
+
+	slices.Sort(codes)
+	return codes
+}
@@ -0,0 +1,608 @@
+//go:build !gonumfmt_minimal
+
+package gonumfmt
+
+import (
+	"errors"
+	"maps"
+	"math"
+	"testing"
+)
+
+func TestFormatter_Parse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		locale   string
+		expected float64
+	}{
+		{"English grouped", "1,234,567.89", "en", 1234567.89},
+		{"English ungrouped", "1234.56", "en", 1234.56},
+		{"English negative", "-1,234.56", "en", -1234.56},
+		{"English plus sign", "+123.45", "en", 123.45},
+		{"English leading decimal", ".5", "en", 0.5},
+		{"German grouped", "1.234,56", "de", 1234.56},
+		{"German ungrouped", "1234,56", "de", 1234.56},
+		{"German negative", "-1.234.567,89", "de", -1234567.89},
+		{"Russian grouped", "1 234 567,89", "ru", 1234567.89},
+		{"French grouped", "1 234,5", "fr", 1234.5},
+		{"Integer", "12,345", "en", 12345},
+		{"Zero", "0", "en", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFormatter(WithLocale(tt.locale))
+			result, err := f.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) in %s returned error: %v", tt.input, tt.locale, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Parse(%q) in %s = %v, expected %v",
+					tt.input, tt.locale, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatter_ParseSpecialValues(t *testing.T) {
+	f := NewFormatter(WithLocale("en"))
+
+	if result, err := f.Parse("NaN"); err != nil || !math.IsNaN(result) {
+		t.Errorf("Parse(NaN) = %v, %v, expected NaN", result, err)
+	}
+	if result, err := f.Parse("∞"); err != nil || !math.IsInf(result, 1) {
+		t.Errorf("Parse(∞) = %v, %v, expected +Inf", result, err)
+	}
+	if result, err := f.Parse("-∞"); err != nil || !math.IsInf(result, -1) {
+		t.Errorf("Parse(-∞) = %v, %v, expected -Inf", result, err)
+	}
+}
+
+func TestFormatter_ParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		locale string
+		err    error
+		offset int
+	}{
+		{"Empty input", "", "en", ErrEmptyInput, 0},
+		{"Letter inside", "12a3", "en", ErrUnexpectedCharacter, 2},
+		{"Trailing text", "1,234.5x", "en", ErrUnexpectedCharacter, 7},
+		{"Sign only", "+", "en", ErrNoDigits, 1},
+		{"Separator only", ".", "en", ErrNoDigits, 0},
+		{"Two decimal separators", "1,2,3", "de", ErrDuplicateDecimalSeparator, 3},
+		{"Group in fraction", "1.234,567", "en", ErrMisplacedGroupSeparator, 5},
+		{"Leading group separator", ",123", "en", ErrMisplacedGroupSeparator, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFormatter(WithLocale(tt.locale))
+			_, err := f.Parse(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Parse(%q) error = %v, expected %v", tt.input, err, tt.err)
+			}
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse(%q) error %T is not *ParseError", tt.input, err)
+			}
+			if parseErr.Offset != tt.offset {
+				t.Errorf("Parse(%q) error offset = %d, expected %d",
+					tt.input, parseErr.Offset, tt.offset)
+			}
+		})
+	}
+}
+
+func TestFormatter_ParseRoundTrip(t *testing.T) {
+	numbers := []float64{0, 1, -1, 12.5, 1234.567, -987654.321, 1e9}
+	locales := []string{"en", "ru", "de", "fr", "ja", "zh"}
+
+	for _, locale := range locales {
+		f := NewFormatter(WithLocale(locale))
+		for _, number := range numbers {
+			formatted := f.Format(number)
+			result, err := f.Parse(formatted)
+			if err != nil {
+				t.Errorf("Parse(%q) in %s returned error: %v", formatted, locale, err)
+				continue
+			}
+			if result != number {
+				t.Errorf("Parse(Format(%v)) in %s = %v", number, locale, result)
+			}
+		}
+	}
+}
+
+func TestFormatter_ParseCurrency(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		locale   string
+		currency string
+		symbols  map[string]string
+		expected float64
+		code     string
+	}{
+		{"USD symbol English", "$1,234.56", "en", "", nil, 1234.56, "USD"},
+		{"USD code English", "USD1,234.56", "en", "", nil, 1234.56, "USD"},
+		{"USD name English", "US Dollar1,234.56", "en", "", nil, 1234.56, "USD"},
+		{"EUR German", "1.234,56 €", "de", "", nil, 1234.56, "EUR"},
+		{"EUR French", "1 234,56 €", "fr", "", nil, 1234.56, "EUR"},
+		{"RUB Russian", "1 234,56 ₽", "ru", "", nil, 1234.56, "RUB"},
+		{"CHF code before number", "CHF 12.50", "en", "", nil, 12.5, "CHF"},
+		{"Symbol without spacing", "12.50€", "en", "", nil, 12.5, "EUR"},
+		{"Longer symbol wins", "A$5", "en", "", nil, 5, "AUD"},
+		{"Negative inside template", "$-5", "en", "", nil, -5, "USD"},
+		{"Negative before symbol", "-$5", "en", "", nil, -5, "USD"},
+		{"Negative after symbol", "CHF-5.00", "de-CH", "", nil, -5, "CHF"},
+		{"Accounting parentheses", "($1,234.56)", "en", "", nil, -1234.56, "USD"},
+		{"Yen in Japanese", "￥1,234", "ja", "", nil, 1234, "JPY"},
+		{"Half-width yen in Japanese", "¥1,234", "ja", "", nil, 1234, "JPY"},
+		{"Yen in Chinese", "¥1,234", "zh", "", nil, 1234, "CNY"},
+		{"Yen with formatter currency", "¥1,234", "en", "CNY", map[string]string{"CNY": "¥"}, 1234, "CNY"},
+		{"Krona with formatter currency", "12 kr", "en", "SEK", map[string]string{"SEK": "kr", "NOK": "kr"}, 12, "SEK"},
+		{"Krona in Swedish", "12 kr", "sv", "", nil, 12, "SEK"},
+		{"No symbol with formatter currency", "1,234.56", "en", "USD", nil, 1234.56, "USD"},
+		{"Unknown formatter currency", "XYZ123.45", "en", "XYZ", nil, 123.45, "XYZ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := []FormatterOption{WithLocale(tt.locale)}
+			if tt.currency != "" {
+				opts = append(opts, WithCurrency(tt.currency))
+			}
+			f := withCurrencySymbols(NewFormatter(opts...), tt.symbols)
+
+			result, code, err := f.ParseCurrency(tt.input)
+			if err != nil {
+				t.Fatalf("ParseCurrency(%q) in %s returned error: %v", tt.input, tt.locale, err)
+			}
+			if result != tt.expected || code != tt.code {
+				t.Errorf("ParseCurrency(%q) in %s = %v %s, expected %v %s",
+					tt.input, tt.locale, result, code, tt.expected, tt.code)
+			}
+		})
+	}
+}
+
+func TestFormatter_ParseCurrencyErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		locale  string
+		symbols map[string]string
+		err     error
+		offset  int
+	}{
+		{"Empty input", "", "en", nil, ErrEmptyInput, 0},
+		{"Ambiguous yen", "¥1,234", "en", map[string]string{"CNY": "¥"}, ErrAmbiguousCurrency, 0},
+		{"Ambiguous krona", "12 kr", "en", map[string]string{"SEK": "kr", "NOK": "kr"}, ErrAmbiguousCurrency, 3},
+		{"No currency", "1,234.56", "en", nil, ErrNoCurrency, 0},
+		{"Unknown currency", "12.50 XYZ", "en", nil, ErrUnexpectedCharacter, 5},
+		{"Bad number", "$12.5x", "en", nil, ErrUnexpectedCharacter, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := withCurrencySymbols(NewFormatter(WithLocale(tt.locale)), tt.symbols)
+			_, _, err := f.ParseCurrency(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseCurrency(%q) error = %v, expected %v", tt.input, err, tt.err)
+			}
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("ParseCurrency(%q) error %T is not *ParseError", tt.input, err)
+			}
+			if parseErr.Offset != tt.offset {
+				t.Errorf("ParseCurrency(%q) error offset = %d, expected %d",
+					tt.input, parseErr.Offset, tt.offset)
+			}
+		})
+	}
+}
+
+// withCurrencySymbols заменяет символы валют в копии данных локали форматтера.
+// В данных CLDR символы в пределах локали различаются, поэтому неоднозначность
+// обозначений проверяется на измененной локали.
+func withCurrencySymbols(f *Formatter, symbols map[string]string) *Formatter {
+	if len(symbols) == 0 {
+		return f
+	}
+
+	locale := *f.locale
+	locale.CurrencyFormats = maps.Clone(locale.CurrencyFormats)
+	for code, symbol := range symbols {
+		data := *f.currencyFormat(code)
+		data.Symbol = symbol
+		locale.CurrencyFormats[code] = &data
+	}
+	f.locale = &locale
+	return f
+}
+
+func TestFormatter_ParseCurrencyRoundTrip(t *testing.T) {
+	currencies := map[string][]string{
+		"en": {"USD", "EUR", "GBP", "JPY", "CNY", "RUB"},
+		"ru": {"USD", "EUR", "RUB"},
+		"de": {"USD", "EUR", "GBP"},
+		"fr": {"EUR", "GBP"},
+		"ja": {"JPY", "USD"},
+		"zh": {"CNY", "EUR"},
+	}
+
+	for locale, codes := range currencies {
+		for _, currency := range codes {
+			for _, display := range []CurrencyDisplay{CurrencySymbol, CurrencyCode, CurrencyName} {
+				f := NewFormatter(
+					WithLocale(locale),
+					WithCurrency(currency),
+					WithCurrencyDisplay(display),
+				)
+				formatted := f.Format(-1234.5)
+				result, code, err := f.ParseCurrency(formatted)
+				if err != nil {
+					t.Errorf("ParseCurrency(%q) in %s returned error: %v", formatted, locale, err)
+					continue
+				}
+				// Валюты без minor units округляются до целых: -1234.5 -> -1234
+				expected := -1234.5
+				if getCurrencyData(currency).Digits == 0 {
+					expected = -1234
+				}
+				if result != expected || code != currency {
+					t.Errorf("ParseCurrency(%q) in %s = %v %s, expected %v %s",
+						formatted, locale, result, code, expected, currency)
+				}
+			}
+		}
+	}
+}
+
+func TestFormatter_ParseCurrencySign(t *testing.T) {
+	locales := map[string]string{"en": "USD", "nl": "EUR", "de-CH": "CHF", "fr": "EUR", "he": "ILS"}
+	numbers := []float64{-1234.5, 0, 12.5}
+
+	for locale, currency := range locales {
+		for _, sign := range []CurrencySign{CurrencySignStandard, CurrencySignAccounting} {
+			for _, display := range []SignDisplay{SignAuto, SignAlways} {
+				f := NewFormatter(
+					WithLocale(locale),
+					WithCurrency(currency),
+					WithCurrencySign(sign),
+					WithSignDisplay(display),
+					WithParseMode(ParseStrict),
+				)
+				for _, number := range numbers {
+					formatted := f.Format(number)
+					result, err := f.ParseCurrencyDetailed(formatted)
+					if err != nil {
+						t.Errorf("ParseCurrencyDetailed(%q) in %s returned error: %v", formatted, locale, err)
+						continue
+					}
+					if result.Value != number || result.Currency != currency || result.Normalized != 0 {
+						t.Errorf("ParseCurrencyDetailed(%q) in %s = %+v, expected %v %s", formatted, locale, result, number, currency)
+					}
+				}
+			}
+		}
+	}
+
+	// Строгий разбор принимает только запись знака форматтера
+	standard := NewFormatter(WithLocale("en"), WithCurrency("USD"), WithParseMode(ParseStrict))
+	if _, _, err := standard.ParseCurrency("($5.00)"); err == nil {
+		t.Error("ParseCurrency(($5.00)) in strict standard mode succeeded")
+	}
+	accounting := NewFormatter(WithLocale("en"), WithCurrency("USD"), WithCurrencySign(CurrencySignAccounting), WithParseMode(ParseStrict))
+	if _, _, err := accounting.ParseCurrency("-$5.00"); err == nil {
+		t.Error("ParseCurrency(-$5.00) in strict accounting mode succeeded")
+	}
+
+	lenient := NewFormatter(WithLocale("en"), WithCurrency("USD"), WithParseMode(ParseLenient))
+	result, err := lenient.ParseCurrencyDetailed("−$5.00")
+	if err != nil || result.Value != -5 || result.Normalized != NormalizedSign {
+		t.Errorf("ParseCurrencyDetailed(−$5.00) = %+v, %v, expected -5 with NormalizedSign", result, err)
+	}
+}
+
+func TestFormatter_ParseCompactAndPercent(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		locale   string
+		expected float64
+	}{
+		{"English thousand", "1.5K", "en", 1500},
+		{"English million", "1.5M", "en", 1500000},
+		{"English negative billion", "-2.5B", "en", -2500000000},
+		{"English long trillion", "7 trillion", "en", 7e12},
+		{"English fractional million", "1.235M", "en", 1235000},
+		{"German billion", "2,3 Mrd.", "de", 2300000000},
+		{"German long million", "1,5 Millionen", "de", 1500000},
+		{"Russian thousand", "1,5 тыс.", "ru", 1500},
+		{"French billion", "2,5 Md", "fr", 2500000000},
+		{"Japanese ten thousand", "150万", "ja", 1500000},
+		{"Japanese hundred million", "1.2億", "ja", 120000000},
+		{"Catalan thousand millions", "1,5mM", "ca", 1.5e9},
+		{"Catalan million", "1,5 M", "ca", 1.5e6},
+		{"English percent", "15%", "en", 0.15},
+		{"English fractional percent", "15.67%", "en", 0.1567},
+		{"English negative percent", "-50%", "en", -0.5},
+		{"German grouped percent", "123.450%", "de", 1234.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFormatter(WithLocale(tt.locale))
+			result, err := f.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) in %s returned error: %v", tt.input, tt.locale, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Parse(%q) in %s = %v, expected %v",
+					tt.input, tt.locale, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatter_ParseCompactAndPercentRoundTrip(t *testing.T) {
+	percentNumbers := []float64{0.1567, -0.5, 12.345, 0}
+	compactNumbers := []float64{1500, -2300000, 1.5e9, 2.5e9, 7e12, 999}
+	locales := []string{"en", "ru", "de", "fr", "ja", "zh", "ca"}
+
+	check := func(f *Formatter, locale string, numbers []float64) {
+		for _, number := range numbers {
+			formatted := f.Format(number)
+			result, err := f.Parse(formatted)
+			if err != nil {
+				t.Errorf("Parse(%q) in %s returned error: %v", formatted, locale, err)
+				continue
+			}
+			if result != number {
+				t.Errorf("Parse(%q) in %s = %v, expected %v", formatted, locale, result, number)
+			}
+		}
+	}
+
+	for _, locale := range locales {
+		check(NewFormatter(WithLocale(locale), WithStyle(Percent)), locale, percentNumbers)
+		check(NewFormatter(WithLocale(locale), WithCompactDisplay(Short)), locale, compactNumbers)
+		check(NewFormatter(WithLocale(locale), WithCompactDisplay(Long)), locale, compactNumbers)
+	}
+}
+
+func TestFormatter_ParseLenient(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		locale     string
+		expected   float64
+		normalized Normalization
+	}{
+		{"Exact input", "1,234.5", "en", 1234.5, 0},
+		{"Surrounding spaces", "  1,234.5\t", "en", 1234.5, NormalizedWhitespace},
+		{"ASCII hyphen in place of minus", "-5", "en", -5, 0},
+		{"Unicode minus", "−1,234.5", "en", -1234.5, NormalizedSign},
+		{"Fullwidth plus", "＋7", "en", 7, NormalizedSign},
+		{"NBSP grouping", "1 234 567,5", "ru", 1234567.5, NormalizedGroupSeparator},
+		{"Narrow NBSP grouping", "1 234,5", "fr", 1234.5, NormalizedGroupSeparator},
+		{"Apostrophe grouping", "1'234'567", "de", 1234567, NormalizedGroupSeparator},
+		{"No grouping", "1234567.5", "en", 1234567.5, NormalizedGrouping},
+		{"Misplaced grouping", "12,34,567", "en", 1234567, NormalizedGrouping},
+		{"Long first group", "1234,567", "en", 1234567, NormalizedGrouping},
+		{"Space before percent", "15 %", "en", 0.15, NormalizedAffix},
+		{"Space before compact suffix", "1.5 M", "en", 1500000, NormalizedAffix},
+		{"Combined", " −1 234 567,5 ", "de", -1234567.5,
+			NormalizedWhitespace | NormalizedSign | NormalizedGroupSeparator},
+		{"Native digits", "١٬٢٣٤٫٥", "ar", 1234.5, 0},
+		{"Latin digits in Arabic locale", "1٬234٫5", "ar", 1234.5, NormalizedDigits},
+		{"Arabic-Indic digits in English locale", "١,٢٣٤.٥", "en", 1234.5, NormalizedDigits},
+		{"Fullwidth digits", "１２３", "en", 123, NormalizedDigits},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFormatter(WithLocale(tt.locale), WithParseMode(ParseLenient))
+			result, err := f.ParseDetailed(tt.input)
+			if err != nil {
+				t.Fatalf("ParseDetailed(%q) in %s returned error: %v", tt.input, tt.locale, err)
+			}
+			if result.Value != tt.expected {
+				t.Errorf("ParseDetailed(%q) in %s = %v, expected %v",
+					tt.input, tt.locale, result.Value, tt.expected)
+			}
+			if result.Normalized != tt.normalized {
+				t.Errorf("ParseDetailed(%q) in %s normalized %v, expected %v",
+					tt.input, tt.locale, result.Normalized, tt.normalized)
+			}
+		})
+	}
+}
+
+func TestFormatter_ParseStrict(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		locale string
+		style  Style
+		err    error
+		offset int
+	}{
+		{"Exact input", "1,234.5", "en", Decimal, nil, 0},
+		{"Exact German input", "-1.234.567,89", "de", Decimal, nil, 0},
+		{"Exact percent", "15.67%", "en", Percent, nil, 0},
+		{"Exact compact", "1,5 Mio.", "de", Compact, nil, 0},
+		{"Surrounding spaces", " 1,234.5", "en", Decimal, ErrUnexpectedCharacter, 0},
+		{"Unicode minus", "−5", "en", Decimal, ErrUnexpectedCharacter, 0},
+		{"NBSP grouping", "1 234", "ru", Decimal, ErrUnexpectedCharacter, 1},
+		{"Missing grouping", "1234567.5", "en", Decimal, ErrMissingGroupSeparator, 1},
+		{"Short group", "1,23,456", "en", Decimal, ErrMisplacedGroupSeparator, 4},
+		{"Long first group", "1234,567", "en", Decimal, ErrMisplacedGroupSeparator, 4},
+		{"Short last group", "1,23.5", "en", Decimal, ErrMisplacedGroupSeparator, 1},
+		{"Percent in decimal style", "15%", "en", Decimal, ErrUnexpectedCharacter, 2},
+		{"Missing percent sign", "15", "en", Percent, ErrUnexpectedCharacter, 2},
+		{"Space before percent", "15 %", "en", Percent, ErrUnexpectedCharacter, 2},
+		{"Compact in decimal style", "1.5M", "en", Decimal, ErrUnexpectedCharacter, 3},
+		{"Indian grouping", "12,34,567.5", "en-IN", Decimal, nil, 0},
+		{"Indian compact", "3.4Cr", "en-IN", Compact, nil, 0},
+		{"Indian missing grouping", "1234567", "en-IN", Decimal, ErrMissingGroupSeparator, 2},
+		{"Indian short group", "12,3,567", "en-IN", Decimal, ErrMisplacedGroupSeparator, 4},
+		{"Western grouping in Indian locale", "1,234,567", "hi", Decimal, ErrMisplacedGroupSeparator, 5},
+		{"Spanish ungrouped", "1234,5", "es", Decimal, nil, 0},
+		{"Spanish grouped", "12.345", "es", Decimal, nil, 0},
+		{"Spanish below minimum grouping", "1.234", "es", Decimal, ErrMisplacedGroupSeparator, 1},
+		{"Spanish missing grouping", "12345", "es", Decimal, ErrMissingGroupSeparator, 2},
+		{"Native digits", "١٬٢٣٤", "ar", Decimal, nil, 0},
+		{"Latin digits in Arabic locale", "1٬234", "ar", Decimal, ErrUnexpectedCharacter, 0},
+		{"Arabic-Indic digits in English locale", "١٢", "en", Decimal, ErrUnexpectedCharacter, 0},
+		{"Native missing grouping", "١٢٣٤٥", "ar", Decimal, ErrMissingGroupSeparator, 4},
+		{"Leading decimal separator", ".5", "en", Decimal, ErrIntegerDigits, 0},
+		{"Trailing decimal separator", "5.", "en", Decimal, ErrFractionDigits, 1},
+		{"Trailing fraction zero", "1.50", "en", Decimal, ErrFractionDigits, 3},
+		{"Too many fraction digits", "1.23456", "en", Decimal, ErrFractionDigits, 5},
+		{"Leading zeros", "0005", "en", Decimal, ErrIntegerDigits, 0},
+		{"Zero before fraction", "0.5", "en", Decimal, nil, 0},
+		{"Plus with SignAuto", "+5", "en", Decimal, ErrSign, 0},
+		{"Negative zero", "-0", "en", Decimal, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFormatter(
+				WithLocale(tt.locale),
+				WithStyle(tt.style),
+				WithParseMode(ParseStrict),
+			)
+			_, err := f.Parse(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Parse(%q) error = %v, expected %v", tt.input, err, tt.err)
+			}
+			if err == nil {
+				return
+			}
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) || parseErr.Offset != tt.offset {
+				t.Errorf("Parse(%q) error = %v, expected offset %d", tt.input, err, tt.offset)
+			}
+		})
+	}
+}
+
+func TestFormatter_ParseStrictSign(t *testing.T) {
+	tests := []struct {
+		input   string
+		display SignDisplay
+		err     error
+	}{
+		{"5", SignAuto, nil},
+		{"-5", SignAuto, nil},
+		{"+5", SignAuto, ErrSign},
+		{"+5", SignAlways, nil},
+		{"5", SignAlways, ErrSign},
+		{"+0", SignAlways, nil},
+		{"5", SignNever, nil},
+		{"-5", SignNever, ErrSign},
+		{"+5", SignExceptZero, nil},
+		{"5", SignExceptZero, ErrSign},
+		{"0", SignExceptZero, nil},
+		{"-0", SignExceptZero, ErrSign},
+		{"+0", SignExceptZero, ErrSign},
+	}
+
+	for _, tt := range tests {
+		f := NewFormatter(WithLocale("en"), WithSignDisplay(tt.display), WithParseMode(ParseStrict))
+		if _, err := f.Parse(tt.input); !errors.Is(err, tt.err) {
+			t.Errorf("Parse(%q) with %v error = %v, expected %v", tt.input, tt.display, err, tt.err)
+		}
+	}
+
+	currency := NewFormatter(WithLocale("en"), WithCurrency("USD"), WithParseMode(ParseStrict))
+	if _, _, err := currency.ParseCurrency("+$5.00"); !errors.Is(err, ErrSign) {
+		t.Errorf("ParseCurrency(+$5.00) with SignAuto error = %v, expected ErrSign", err)
+	}
+	if _, _, err := currency.ParseCurrency("$5.0"); !errors.Is(err, ErrFractionDigits) {
+		t.Errorf("ParseCurrency($5.0) error = %v, expected ErrFractionDigits", err)
+	}
+}
+
+func TestFormatter_ParseStrictRoundTrip(t *testing.T) {
+	numbers := []float64{0, -1, 12.5, 1234.567, -987654.321}
+	locales := []string{"en", "ru", "de", "fr", "ja", "zh"}
+
+	for _, locale := range locales {
+		f := NewFormatter(WithLocale(locale), WithParseMode(ParseStrict))
+		for _, number := range numbers {
+			formatted := f.Format(number)
+			result, err := f.ParseDetailed(formatted)
+			if err != nil {
+				t.Errorf("ParseDetailed(%q) in %s returned error: %v", formatted, locale, err)
+				continue
+			}
+			if result.Value != number || result.Normalized != 0 {
+				t.Errorf("ParseDetailed(%q) in %s = %+v, expected %v", formatted, locale, result, number)
+			}
+		}
+	}
+}
+
+func TestFormatter_ParseNumberingSystemRoundTrip(t *testing.T) {
+	numbers := []float64{0, -1, 12.5, 1234.567, -987654.321}
+	locales := []string{"ar", "fa", "bn", "mr", "en-u-nu-arab", "en-u-nu-deva", "de-u-nu-thai"}
+	modes := []ParseMode{ParseStrict, ParseLenient}
+
+	for _, locale := range locales {
+		for _, mode := range modes {
+			f := NewFormatter(WithLocale(locale), WithParseMode(mode))
+			c := NewFormatter(WithLocale(locale), WithParseMode(mode), WithCurrency("EUR"))
+			for _, number := range numbers {
+				formatted := f.Format(number)
+				result, err := f.ParseDetailed(formatted)
+				if err != nil {
+					t.Errorf("ParseDetailed(%q) in %s returned error: %v", formatted, locale, err)
+				} else if result.Value != number || result.Normalized != 0 {
+					t.Errorf("ParseDetailed(%q) in %s = %+v, expected %v", formatted, locale, result, number)
+				}
+
+				formatted = c.Format(number)
+				value, currency, err := c.ParseCurrency(formatted)
+				if err != nil {
+					t.Errorf("ParseCurrency(%q) in %s returned error: %v", formatted, locale, err)
+				} else if value != math.Round(number*100)/100 || currency != "EUR" {
+					t.Errorf("ParseCurrency(%q) in %s = %v %s, expected %v EUR", formatted, locale, value, currency, number)
+				}
+			}
+		}
+	}
+}
+
+func TestDigitValue(t *testing.T) {
+	tests := []struct {
+		r     rune
+		value int
+		ok    bool
+	}{
+		{'7', 7, true},
+		{'٣', 3, true},
+		{'۹', 9, true},
+		{'৫', 5, true},
+		{'０', 0, true},
+		{'𝟗', 9, true}, // математические цифры идут несколькими блоками подряд
+		{'𝟘', 0, true},
+		{'a', 0, false},
+		{'二', 0, false},
+	}
+
+	for _, tt := range tests {
+		value, ok := digitValue(tt.r)
+		if value != tt.value || ok != tt.ok {
+			t.Errorf("digitValue(%q) = %d, %v, expected %d, %v", tt.r, value, ok, tt.value, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package gonumfmt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidLocaleData возвращается, если данные локали нельзя зарегистрировать
+var ErrInvalidLocaleData = errors.New("invalid locale data")
+
+// RegisterLocale регистрирует полные данные локали, например фирменный стиль
+// "en-CH" с апострофом в группах. Тег приводится к тому же ключу, что и при
+// поиске, поэтому локаль сразу доступна через GetLocaleData, IsLocaleSupported
+// и SupportedLocales, а региональные варианты встроенных данных наследуют ее.
+// Данные копируются; повторная регистрация заменяет прежние.
+func RegisterLocale(tag string, data *LocaleData) error {
+	key := languageTag(tag)
+	if key == "" {
+		return fmt.Errorf("gonumfmt: locale %q: %w: bad language tag", tag, ErrInvalidLocaleData)
+	}
+	if data == nil {
+		return fmt.Errorf("gonumfmt: locale %q: %w: nil data", tag, ErrInvalidLocaleData)
+	}
+	if err := validateLocaleData(data); err != nil {
+		return fmt.Errorf("gonumfmt: locale %q: %w: %v", tag, ErrInvalidLocaleData, err)
+	}
+
+	registerLocales(map[string]*LocaleData{key: data.clone()})
+	return nil
+}
+
+// registerLocales добавляет проверенные данные по ключам и заменяет снимок
+// локалей: новая локаль может изменить разрешение уже загруженных тегов.
+// Зарегистрированные локали заменяют встроенные данные с тем же ключом.
+func registerLocales(registered map[string]*LocaleData) {
+	replaceLocales(func(_, _, custom map[string]*LocaleData) {
+		maps.Copy(custom, registered)
+	})
+}
+
+// validateLocaleData проверяет, что данные пригодны для форматирования
+func validateLocaleData(data *LocaleData) error {
+	for _, pattern := range []struct{ name, value string }{
+		{"CurrencyPattern", data.CurrencyPattern},
+		{"NegativePattern", data.NegativePattern},
+		{"PositivePattern", data.PositivePattern},
+		{"PercentPattern", data.PercentPattern},
+	} {
+		if !strings.Contains(pattern.value, "{number}") {
+			return fmt.Errorf("%s %q has no {number}", pattern.name, pattern.value)
+		}
+	}
+	// Шаблоны отрицательных сумм и бухгалтерского формата необязательны
+	for _, pattern := range []struct{ name, value string }{
+		{"CurrencyNegativePattern", data.CurrencyNegativePattern},
+		{"AccountingPattern", data.AccountingPattern},
+		{"AccountingNegativePattern", data.AccountingNegativePattern},
+	} {
+		if pattern.value != "" && !strings.Contains(pattern.value, "{number}") {
+			return fmt.Errorf("%s %q has no {number}", pattern.name, pattern.value)
+		}
+	}
+	if data.DecimalSeparator == "" {
+		return errors.New("empty DecimalSeparator")
+	}
+	// Без знака минуса отрицательные числа выводились бы как положительные
+	if data.MinusSign == "" {
+		return errors.New("empty MinusSign")
+	}
+	if data.PrimaryGroupSize < 0 || data.SecondaryGroupSize < 0 || data.MinimumGroupingDigits < 0 {
+		return errors.New("negative group size")
+	}
+	for code, currency := range data.CurrencyFormats {
+		if currency == nil {
+			return fmt.Errorf("nil currency %s", code)
+		}
+	}
+	for compactRange, pattern := range data.CompactPatterns {
+		if pattern == nil {
+			return fmt.Errorf("nil compact pattern %d", compactRange)
+		}
+	}
+	return nil
+}
+
+// localeDefinition описывает локаль в JSON. Указатели отличают отсутствующие
+// поля от пустых строк.
+type localeDefinition struct {
+	Locale                 *string                       `json:"locale"`
+	DecimalSeparator       *string                       `json:"decimalSeparator"`
+	GroupSeparator         *string                       `json:"groupSeparator"`
+	PrimaryGroupSize       int                           `json:"primaryGroupSize"`
+	SecondaryGroupSize     int                           `json:"secondaryGroupSize"`
+	MinimumGroupingDigits  int                           `json:"minimumGroupingDigits"`
+	PercentSymbol          *string                       `json:"percentSymbol"`
+	CurrencyPattern        *string                       `json:"currencyPattern"`
+	CurrencyNegative       string                        `json:"currencyNegativePattern"`
+	AccountingPattern      string                        `json:"accountingPattern"`
+	AccountingNegative     string                        `json:"accountingNegativePattern"`
+	NegativePattern        *string                       `json:"negativePattern"`
+	PositivePattern        *string                       `json:"positivePattern"`
+	PercentPattern         *string                       `json:"percentPattern"`
+	MinusSign              *string                       `json:"minusSign"`
+	PlusSign               *string                       `json:"plusSign"`
+	Exponential            *string                       `json:"exponential"`
+	Infinity               string                        `json:"infinity"`
+	NaN                    string                        `json:"nan"`
+	SuperscriptingExponent bool                          `json:"superscriptingExponent"`
+	NumberingSystem        string                        `json:"numberingSystem"`
+	DefaultCurrency        string                        `json:"defaultCurrency"`
+	Currencies             map[string]currencyDefinition `json:"currencies"`
+	CompactPatterns        map[string]CompactPattern     `json:"compactPatterns"`
+}
+
+// currencyDefinition описывает валюту локали в JSON
+type currencyDefinition struct {
+	Symbol string `json:"symbol"`
+	Name   string `json:"name"`
+	Format string `json:"format"`
+}
+
+// DecodeLocale читает определение локали из JSON и возвращает ее тег и
+// данные. Обязательны поля locale, decimalSeparator, groupSeparator,
+// percentSymbol, currencyPattern, negativePattern, positivePattern,
+// percentPattern, minusSign, plusSign и exponential; currencyNegativePattern,
+// accountingPattern и accountingNegativePattern необязательны. Компактные шаблоны
+// задаются по десятичному порядку: {"3": {"short": "0K", "long": "0 thousand"}}.
+func DecodeLocale(r io.Reader) (string, *LocaleData, error) {
+	var def localeDefinition
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&def); err != nil {
+		return "", nil, fmt.Errorf("gonumfmt: decode locale: %w", err)
+	}
+
+	var missing []string
+	required := func(name string, value *string) string {
+		if value == nil {
+			missing = append(missing, name)
+			return ""
+		}
+		return *value
+	}
+
+	tag := required("locale", def.Locale)
+	data := &LocaleData{
+		DecimalSeparator:          required("decimalSeparator", def.DecimalSeparator),
+		GroupSeparator:            required("groupSeparator", def.GroupSeparator),
+		PrimaryGroupSize:          def.PrimaryGroupSize,
+		SecondaryGroupSize:        def.SecondaryGroupSize,
+		MinimumGroupingDigits:     def.MinimumGroupingDigits,
+		PercentSymbol:             required("percentSymbol", def.PercentSymbol),
+		CurrencyPattern:           required("currencyPattern", def.CurrencyPattern),
+		CurrencyNegativePattern:   def.CurrencyNegative,
+		AccountingPattern:         def.AccountingPattern,
+		AccountingNegativePattern: def.AccountingNegative,
+		NegativePattern:           required("negativePattern", def.NegativePattern),
+		PositivePattern:           required("positivePattern", def.PositivePattern),
+		PercentPattern:            required("percentPattern", def.PercentPattern),
+		MinusSign:                 required("minusSign", def.MinusSign),
+		PlusSign:                  required("plusSign", def.PlusSign),
+		Exponential:               required("exponential", def.Exponential),
+		Infinity:                  def.Infinity,
+		NaN:                       def.NaN,
+		SuperscriptingExponent:    def.SuperscriptingExponent,
+		NumberingSystem:           def.NumberingSystem,
+		DefaultCurrency:           strings.ToUpper(def.DefaultCurrency),
+		CurrencyFormats:           make(map[string]*CurrencyData, len(def.Currencies)),
+		CompactPatterns:           make(map[CompactRange]*CompactPattern, len(def.CompactPatterns)),
+	}
+	if len(missing) > 0 {
+		return tag, nil, fmt.Errorf("gonumfmt: locale %q: %w: missing %s", tag, ErrInvalidLocaleData, strings.Join(missing, ", "))
+	}
+
+	for code, currency := range def.Currencies {
+		data.CurrencyFormats[strings.ToUpper(code)] = &CurrencyData{
+			Symbol: currency.Symbol,
+			Name:   currency.Name,
+			Format: currency.Format,
+		}
+	}
+
+	for key, pattern := range def.CompactPatterns {
+		exponent, err := strconv.Atoi(key)
+		compactRange, known := compactRangeOf(exponent)
+		if err != nil || !known {
+			return tag, nil, fmt.Errorf("gonumfmt: locale %q: %w: unknown compact exponent %q", tag, ErrInvalidLocaleData, key)
+		}
+		data.CompactPatterns[compactRange] = &pattern
+	}
+
+	if err := validateLocaleData(data); err != nil {
+		return tag, nil, fmt.Errorf("gonumfmt: locale %q: %w: %v", tag, ErrInvalidLocaleData, err)
+	}
+	return tag, data, nil
+}
+
+// LoadLocale читает определение локали из JSON (см. DecodeLocale) и
+// регистрирует ее
+func LoadLocale(r io.Reader) error {
+	tag, data, err := DecodeLocale(r)
+	if err != nil {
+		return err
+	}
+	return RegisterLocale(tag, data)
+}
+
+// LoadLocales регистрирует все определения локалей из fs.FS, подходящие под
+// шаблон fs.Glob, например "locales/*.json". Локали регистрируются, только
+// если все файлы корректны.
+func LoadLocales(fsys fs.FS, pattern string) error {
+	names, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return fmt.Errorf("gonumfmt: load locales: %w", err)
+	}
+
+	decoded := make(map[string]*LocaleData, len(names))
+	for _, name := range names {
+		file, err := fsys.Open(name)
+		if err != nil {
+			return fmt.Errorf("gonumfmt: load locales: %w", err)
+		}
+		tag, data, err := DecodeLocale(file)
+		file.Close()
+		if err == nil && languageTag(tag) == "" {
+			err = fmt.Errorf("gonumfmt: locale %q: %w: bad language tag", tag, ErrInvalidLocaleData)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		decoded[tag] = data
+	}
+
+	// Снимок заменяется один раз; при совпадении ключей побеждает
+	// последний тег по порядку сортировки
+	registered := make(map[string]*LocaleData, len(decoded))
+	for _, tag := range slices.Sorted(maps.Keys(decoded)) {
+		registered[languageTag(tag)] = decoded[tag]
+	}
+	registerLocales(registered)
+	return nil
+}
@@ -0,0 +1,207 @@
+//go:build !gonumfmt_minimal
+
+package gonumfmt
+
+import (
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// unregisterLocales удаляет зарегистрированные в тесте локали
+func unregisterLocales(t *testing.T) {
+	t.Cleanup(func() {
+		replaceLocales(func(_, _, custom map[string]*LocaleData) {
+			clear(custom)
+		})
+	})
+}
+
+func TestRegisterLocale(t *testing.T) {
+	unregisterLocales(t)
+
+	data := GetLocaleData("en").clone()
+	data.GroupSeparator = "'"
+	data.CurrencyPattern = "{symbol} {number}"
+	if err := RegisterLocale("en_CH", data); err != nil {
+		t.Fatal(err)
+	}
+	// Изменение исходных данных после регистрации не влияет на локаль
+	data.GroupSeparator = "?"
+
+	f := NewFormatter(WithLocale("en-CH"))
+	if result := f.Format(1234567.5); result != "1'234'567.5" {
+		t.Errorf("Format in registered en-CH = %q, expected %q", result, "1'234'567.5")
+	}
+	if chain := LocaleChain("en-CH-u-nu-latn"); !slices.Equal(chain, []string{"en-ch", "root"}) {
+		t.Errorf("LocaleChain(en-CH) = %v", chain)
+	}
+	if !slices.Contains(SupportedLocales(), "en-ch") {
+		t.Error("SupportedLocales() does not contain en-ch")
+	}
+
+	// Регистрация заменяет уже загруженные в кэш данные
+	GetLocaleData("de-CH")
+	de := GetLocaleData("de").clone()
+	de.DecimalSeparator = "·"
+	if err := RegisterLocale("de", de); err != nil {
+		t.Fatal(err)
+	}
+	if sep := GetLocaleData("de-AT").DecimalSeparator; sep != "·" {
+		t.Errorf("de-AT DecimalSeparator = %q, expected the registered de separator", sep)
+	}
+	if sep := GetLocaleData("de-CH").DecimalSeparator; sep != "." {
+		t.Errorf("de-CH DecimalSeparator = %q, expected its own overlay", sep)
+	}
+	// Замена встроенной локали не дублирует ее в списке
+	count := 0
+	for _, locale := range SupportedLocales() {
+		if locale == "de" {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Errorf("SupportedLocales() contains de %d times, expected once", count)
+	}
+}
+
+func TestRegisterLocaleErrors(t *testing.T) {
+	unregisterLocales(t)
+
+	valid := GetLocaleData("en")
+	broken := valid.clone()
+	broken.PercentPattern = "%"
+	brokenAccounting := valid.clone()
+	brokenAccounting.AccountingNegativePattern = "()"
+	noMinus := valid.clone()
+	noMinus.MinusSign = ""
+	noDecimal := valid.clone()
+	noDecimal.DecimalSeparator = ""
+
+	tests := []struct {
+		name string
+		tag  string
+		data *LocaleData
+	}{
+		{"Empty tag", "", valid},
+		{"Nil data", "en-CH", nil},
+		{"Pattern without number", "en-CH", broken},
+		{"Accounting pattern without number", "en-CH", brokenAccounting},
+		{"Empty minus sign", "en-CH", noMinus},
+		{"Empty decimal separator", "en-CH", noDecimal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := RegisterLocale(tt.tag, tt.data); !errors.Is(err, ErrInvalidLocaleData) {
+				t.Errorf("RegisterLocale() error = %v, expected ErrInvalidLocaleData", err)
+			}
+		})
+	}
+}
+
+func TestLoadLocale(t *testing.T) {
+	unregisterLocales(t)
+
+	file, err := os.Open("testdata/locales/en-CH.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if err := LoadLocale(file); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		opts     []FormatterOption
+		expected string
+	}{
+		{"Decimal", nil, "1'234'567.5"},
+		{"Currency", []FormatterOption{WithStyle(Currency), WithCurrency("CHF")}, "CHF 1'234'567.50"},
+		{"Compact", []FormatterOption{WithStyle(Compact), WithCompactDisplay(Long)}, "1.235 million"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFormatter(append([]FormatterOption{WithLocale("en-CH")}, tt.opts...)...)
+			if result := f.Format(1234567.5); result != tt.expected {
+				t.Errorf("Format = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+
+	for sign, expected := range map[CurrencySign]string{CurrencySignStandard: "CHF-5.00", CurrencySignAccounting: "(CHF 5.00)"} {
+		f := NewFormatter(WithLocale("en-CH"), WithCurrency("CHF"), WithCurrencySign(sign))
+		if result := f.Format(-5); result != expected {
+			t.Errorf("Format(-5) with currency sign %d = %q, expected %q", sign, result, expected)
+		}
+	}
+
+	if currency := GetLocaleData("en-CH").DefaultCurrency; currency != "CHF" {
+		t.Errorf("DefaultCurrency = %q, expected CHF", currency)
+	}
+}
+
+func TestDecodeLocaleErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		json     string
+		contains string
+	}{
+		{"Missing fields", `{"locale": "en-CH", "decimalSeparator": "."}`, "missing groupSeparator, percentSymbol"},
+		{"Missing tag", `{}`, "missing locale"},
+		{"Unknown field", `{"locale": "en-CH", "groupSize": 3}`, "unknown field"},
+		{"Bad JSON", `{"locale": `, "decode locale"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := DecodeLocale(strings.NewReader(tt.json))
+			if err == nil || !strings.Contains(err.Error(), tt.contains) {
+				t.Errorf("DecodeLocale() error = %v, expected to contain %q", err, tt.contains)
+			}
+		})
+	}
+
+	definition, err := os.ReadFile("testdata/locales/en-CH.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	badCompact := strings.Replace(string(definition), `"6":`, `"10":`, 1)
+	if _, _, err := DecodeLocale(strings.NewReader(badCompact)); !errors.Is(err, ErrInvalidLocaleData) {
+		t.Errorf("DecodeLocale() with unknown compact exponent error = %v", err)
+	}
+	emptyMinus := strings.Replace(string(definition), `"minusSign": "-"`, `"minusSign": ""`, 1)
+	if _, _, err := DecodeLocale(strings.NewReader(emptyMinus)); !errors.Is(err, ErrInvalidLocaleData) || !strings.Contains(err.Error(), "empty MinusSign") {
+		t.Errorf("DecodeLocale() with empty minusSign error = %v", err)
+	}
+}
+
+func TestLoadLocales(t *testing.T) {
+	unregisterLocales(t)
+
+	definition, err := os.ReadFile("testdata/locales/en-CH.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := fstest.MapFS{
+		"locales/en-CH.json": {Data: definition},
+		"locales/fr-XX.json": {Data: []byte(strings.Replace(string(definition), `"en-CH"`, `"fr-XX"`, 1))},
+		"locales/README.md":  {Data: []byte("not a locale")},
+	}
+	if err := LoadLocales(fsys, "locales/*.json"); err != nil {
+		t.Fatal(err)
+	}
+	for _, locale := range []string{"en-ch", "fr-xx"} {
+		if !slices.Contains(SupportedLocales(), locale) {
+			t.Errorf("SupportedLocales() does not contain %s", locale)
+		}
+	}
+
+	fsys["locales/broken.json"] = &fstest.MapFile{Data: []byte(`{"locale": "it-XX"}`)}
+	err = LoadLocales(fsys, "locales/*.json")
+	if !errors.Is(err, ErrInvalidLocaleData) || !strings.Contains(err.Error(), "broken.json") {
+		t.Errorf("LoadLocales() error = %v, expected ErrInvalidLocaleData for broken.json", err)
+	}
+}
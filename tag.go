@@ -0,0 +1,189 @@
+package gonumfmt
+
+import "strings"
+
+// localeTag содержит подтеги тега BCP 47 в нижнем регистре, как ключи localeData
+type localeTag struct {
+	language   string
+	script     string
+	region     string
+	variants   []string
+	extensions []string // расширения вместе с singleton: "u-nu-arab", "x-private"
+}
+
+// parseLocaleTag разбирает тег BCP 47: язык, письменность, регион, варианты
+// и расширения. Регистр не важен, разделителем может быть "_". Подтеги, не
+// подходящие по форме и позиции, пропускаются.
+func parseLocaleTag(locale string) localeTag {
+	var tag localeTag
+	subtags := strings.Split(normalizeLocale(locale), "-")
+
+	i := 0
+	if isAlpha(subtags[0]) && len(subtags[0]) >= 2 && len(subtags[0]) <= 8 {
+		tag.language = subtags[0]
+		i++
+		// Расширенный подтег языка заменяет основной: "zh-yue" -> "yue"
+		if i < len(subtags) && len(subtags[i]) == 3 && isAlpha(subtags[i]) {
+			tag.language = subtags[i]
+			i++
+		}
+	}
+	if i < len(subtags) && len(subtags[i]) == 4 && isAlpha(subtags[i]) {
+		tag.script = subtags[i]
+		i++
+	}
+	if i < len(subtags) && isRegion(subtags[i]) {
+		tag.region = subtags[i]
+		i++
+	}
+
+	for ; i < len(subtags); i++ {
+		subtag := subtags[i]
+		if subtag == "x" {
+			// Частное расширение продолжается до конца тега
+			tag.extensions = append(tag.extensions, strings.Join(subtags[i:], "-"))
+			break
+		}
+		if len(subtag) == 1 {
+			end := i + 1
+			for end < len(subtags) && len(subtags[end]) > 1 {
+				end++
+			}
+			if end > i+1 {
+				tag.extensions = append(tag.extensions, strings.Join(subtags[i:end], "-"))
+			}
+			i = end - 1
+			continue
+		}
+		if tag.extensions == nil && isVariant(subtag) {
+			tag.variants = append(tag.variants, subtag)
+		}
+	}
+	return tag
+}
+
+// isAlpha проверяет, что подтег состоит из латинских букв
+func isAlpha(subtag string) bool {
+	for i := 0; i < len(subtag); i++ {
+		if subtag[i] < 'a' || subtag[i] > 'z' {
+			return false
+		}
+	}
+	return subtag != ""
+}
+
+// isDigits проверяет, что подтег состоит из цифр
+func isDigits(subtag string) bool {
+	for i := 0; i < len(subtag); i++ {
+		if subtag[i] < '0' || subtag[i] > '9' {
+			return false
+		}
+	}
+	return subtag != ""
+}
+
+// isRegion проверяет подтег региона: две буквы или три цифры ("419")
+func isRegion(subtag string) bool {
+	return len(subtag) == 2 && isAlpha(subtag) || len(subtag) == 3 && isDigits(subtag)
+}
+
+// isVariant проверяет подтег варианта: 5-8 символов или 4, начиная с цифры ("1996")
+func isVariant(subtag string) bool {
+	return len(subtag) >= 5 && len(subtag) <= 8 || len(subtag) == 4 && isDigits(subtag[:1])
+}
+
+// canonicalize заменяет устаревшие коды языка и региона по псевдонимам CLDR:
+// "iw" -> "he", "no" -> "nb", "sh" -> "sr-Latn", "UK" -> "GB". Неопределенный
+// язык "und" означает корневую локаль.
+func (t localeTag) canonicalize() localeTag {
+	if t.language == "und" {
+		return localeTag{language: rootLocale, extensions: t.extensions}
+	}
+	if replacement, exists := languageAliases[t.language]; exists {
+		t = t.fill(parseLocaleTag(replacement), true)
+	}
+	if replacement, exists := regionAliases[t.region]; exists {
+		t.region = replacement
+	}
+	return t
+}
+
+// maximize дополняет тег наиболее вероятными письменностью и регионом
+// (CLDR likelySubtags): "zh-TW" -> "zh-Hant-TW", "sr-ME" -> "sr-Latn-ME"
+func (t localeTag) maximize() localeTag {
+	for _, key := range []localeTag{
+		{language: t.language, script: t.script, region: t.region},
+		{language: t.language, region: t.region},
+		{language: t.language, script: t.script},
+		{language: t.language},
+	} {
+		if expanded, exists := likelySubtags[key.String()]; exists {
+			return t.fill(parseLocaleTag(expanded), false)
+		}
+	}
+	return t
+}
+
+// fill берет из другого тега письменность и регион, которых нет в теге;
+// при замене языка заменяется и язык
+func (t localeTag) fill(other localeTag, replaceLanguage bool) localeTag {
+	if replaceLanguage {
+		t.language = other.language
+	}
+	if t.script == "" {
+		t.script = other.script
+	}
+	if t.region == "" {
+		t.region = other.region
+	}
+	return t
+}
+
+// String возвращает тег без расширений в нижнем регистре
+func (t localeTag) String() string {
+	subtags := make([]string, 0, 3+len(t.variants))
+	for _, subtag := range append([]string{t.language, t.script, t.region}, t.variants...) {
+		if subtag != "" {
+			subtags = append(subtags, subtag)
+		}
+	}
+	return strings.Join(subtags, "-")
+}
+
+// unicodeExtension возвращает значение ключа расширения "-u-"
+func (t localeTag) unicodeExtension(key string) string {
+	for _, extension := range t.extensions {
+		subtags := strings.Split(extension, "-")
+		if subtags[0] != "u" {
+			continue
+		}
+		for i := 1; i+1 < len(subtags); i++ {
+			if subtags[i] == key && len(subtags[i+1]) > 2 {
+				return subtags[i+1]
+			}
+		}
+	}
+	return ""
+}
+
+// languageTag возвращает ключ поиска данных локали: тег приводится к
+// каноническому виду, письменность определяется по likely subtags и
+// сохраняется, только если отличается от основной письменности языка.
+// Регион берется из самого тега. Так "zh-TW" и "zh-Hant" ищутся как
+// "zh-hant-tw" и "zh-hant", а "zh-Hans-CN" как "zh-cn".
+func languageTag(locale string) string {
+	tag := parseLocaleTag(locale).canonicalize()
+	maximized := tag.maximize()
+
+	key := localeTag{language: tag.language, script: maximized.script, region: tag.region, variants: tag.variants}
+	if key.script == (localeTag{language: tag.language}).maximize().script {
+		key.script = ""
+	}
+	return key.String()
+}
+
+// unicodeExtension возвращает значение ключа расширения "-u-" тега BCP 47,
+// например "arab" для ключа "nu" в "ar-EG-u-nu-arab"
+func unicodeExtension(locale, key string) string {
+	return parseLocaleTag(locale).unicodeExtension(key)
+}